@@ -1,10 +1,12 @@
 package main
 
 import (
+	"errors"
 	"os"
 
 	"github.com/go-logr/logr"
 	"github.com/kyverno/chainsaw/pkg/commands"
+	"github.com/kyverno/chainsaw/pkg/runner"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -12,6 +14,9 @@ func main() {
 	log.SetLogger(logr.Discard())
 	root := commands.RootCommand()
 	if err := root.Execute(); err != nil {
+		if errors.Is(err, runner.ErrInterrupted) {
+			os.Exit(runner.InterruptedExitCode)
+		}
 		os.Exit(1)
 	}
 }
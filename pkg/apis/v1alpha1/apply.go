@@ -4,6 +4,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DefaultFieldManager is the field manager used by server-side apply when none is specified.
+const DefaultFieldManager = "chainsaw"
+
 // Apply represents a set of configurations or resources that
 // should be applied during testing.
 type Apply struct {
@@ -11,14 +14,43 @@ type Apply struct {
 	// +optional
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 
+	// Polling defines how resources are polled by the operation.
+	// Overrides the polling set in the step, in the Test and the global polling set in the Configuration.
+	// +optional
+	Polling *Polling `json:"polling,omitempty"`
+
 	// FileRefOrResource provides a reference to the resources to be applied.
 	FileRefOrResource `json:",inline"`
 
+	// Template determines whether resources should be considered for templating.
+	// Overrides the setting in the Test and the global setting in the Configuration.
+	// +optional
+	Template *bool `json:"template,omitempty"`
+
 	// DryRun determines whether the file should be applied in dry run mode.
 	// +optional
 	DryRun *bool `json:"dryRun,omitempty"`
 
+	// ServerSide determines whether the resources should be applied using server-side apply.
+	// Overrides the global setting in the Configuration.
+	// +optional
+	ServerSide *bool `json:"serverSide,omitempty"`
+
+	// FieldManager is the name of the field manager used by server-side apply.
+	// Overrides the global setting in the Configuration.
+	// +optional
+	FieldManager string `json:"fieldManager,omitempty"`
+
+	// ForceConflicts determines whether server-side apply should take ownership of conflicting fields.
+	// Overrides the global setting in the Configuration.
+	// +optional
+	ForceConflicts *bool `json:"forceConflicts,omitempty"`
+
 	// Expect defines a list of matched checks to validate the operation outcome.
 	// +optional
 	Expect []Expectation `json:"expect,omitempty"`
+
+	// Outputs defines output bindings.
+	// +optional
+	Outputs []Output `json:"outputs,omitempty"`
 }
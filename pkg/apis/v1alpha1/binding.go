@@ -0,0 +1,16 @@
+package v1alpha1
+
+import (
+	"github.com/kyverno/kyverno-json/pkg/apis/v1alpha1"
+)
+
+// Binding represents a named value made available to operations as `$name`.
+type Binding struct {
+	// Name the name of the binding.
+	Name string `json:"name"`
+
+	// Value contains the value of the binding, strings enclosed in parenthesis are evaluated as JMESPath expressions.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Value v1alpha1.Any `json:"value"`
+}
@@ -14,6 +14,14 @@ type Catch struct {
 	// +optional
 	Events *Events `json:"events,omitempty"`
 
+	// Get determines the objects to collect and log as YAML.
+	// +optional
+	Get *Get `json:"get,omitempty"`
+
+	// Describe determines the objects to describe.
+	// +optional
+	Describe *Describe `json:"describe,omitempty"`
+
 	// Command defines a command to run.
 	// +optional
 	Command *Command `json:"command,omitempty"`
@@ -24,4 +24,8 @@ type Command struct {
 	// Check is an assertion tree to validate the operation outcome.
 	// +optional
 	Check *Check `json:"check,omitempty"`
+
+	// Outputs defines output bindings.
+	// +optional
+	Outputs []Output `json:"outputs,omitempty"`
 }
@@ -5,9 +5,12 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 type ReportFormatType string
 
 const (
-	JSONFormat ReportFormatType = "JSON"
-	XMLFormat  ReportFormatType = "XML"
-	NoReport   ReportFormatType = ""
+	JSONFormat     ReportFormatType = "JSON"
+	XMLFormat      ReportFormatType = "XML"
+	JUnitFormat    ReportFormatType = "JUNIT"
+	HTMLFormat     ReportFormatType = "HTML"
+	MarkdownFormat ReportFormatType = "MARKDOWN"
+	NoReport       ReportFormatType = ""
 )
 
 // ConfigurationSpec contains the configuration used to run tests.
@@ -16,6 +19,10 @@ type ConfigurationSpec struct {
 	// +optional
 	Timeouts Timeouts `json:"timeouts"`
 
+	// Global polling configuration. Applies to all tests/test steps if not overridden.
+	// +optional
+	Polling *Polling `json:"polling,omitempty"`
+
 	// If set, do not delete the resources after running the tests (implies SkipClusterDelete).
 	// +optional
 	SkipDelete bool `json:"skipDelete,omitempty"`
@@ -30,10 +37,10 @@ type ConfigurationSpec struct {
 	// +optional
 	Parallel *int `json:"parallel,omitempty"`
 
-	// ReportFormat determines test report format (JSON|XML|nil) nil == no report.
+	// ReportFormat determines test report format (JSON|XML|JUNIT|HTML|MARKDOWN|nil) nil == no report.
 	// maps to report.Type, however we don't want generated.deepcopy to have reference to it.
 	// +optional
-	// +kubebuilder:validation:Enum=JSON;XML;
+	// +kubebuilder:validation:Enum=JSON;XML;JUNIT;HTML;MARKDOWN;
 	ReportFormat ReportFormatType `json:"reportFormat,omitempty"`
 
 	// ReportName defines the name of report to create. It defaults to "chainsaw-report".
@@ -41,6 +48,20 @@ type ConfigurationSpec struct {
 	// +kubebuilder:default:="chainsaw-report"
 	ReportName string `json:"reportName,omitempty"`
 
+	// GitHubStepSummary appends a Markdown summary of the tests results to the file
+	// named by the GITHUB_STEP_SUMMARY environment variable, if set.
+	// +optional
+	GitHubStepSummary bool `json:"githubStepSummary,omitempty"`
+
+	// ArtifactsDir defines the directory where collectors write their output.
+	// Every test and test step gets its own folder, nothing is written if not specified.
+	// +optional
+	ArtifactsDir string `json:"artifactsDir,omitempty"`
+
+	// OnFailure defines actions to be executed when a test fails.
+	// +optional
+	OnFailure *OnFailure `json:"onFailure,omitempty"`
+
 	// Namespace defines the namespace to use for tests.
 	// If not specified, every test will execute in a random ephemeral namespace
 	// unless the namespace is overridden in a the test spec.
@@ -59,12 +80,38 @@ type ConfigurationSpec struct {
 	// +optional
 	IncludeTestRegex string `json:"includeTestRegex,omitempty"`
 
+	// Sharding splits the tests into multiple shards and runs only one of them.
+	// +optional
+	Sharding *Sharding `json:"sharding,omitempty"`
+
+	// RerunFailed is the path to a JSON report of a previous run, only the tests that failed in this run are executed.
+	// +optional
+	RerunFailed string `json:"rerunFailed,omitempty"`
+
 	// RepeatCount indicates how many times the tests should be executed.
 	// +kubebuilder:validation:Format:=int
 	// +kubebuilder:validation:Minimum:=1
 	// +optional
 	RepeatCount *int `json:"repeatCount,omitempty"`
 
+	// Retries indicates how many times a failed test is retried.
+	// Every attempt runs in a fresh namespace, tests that pass after being retried are reported as flaky.
+	// +kubebuilder:validation:Format:=int
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	Retries *int `json:"retries,omitempty"`
+
+	// FailOnFlaky determines whether flaky tests (tests that passed after being retried) are considered failures.
+	// +optional
+	FailOnFlaky bool `json:"failOnFlaky,omitempty"`
+
+	// Shuffle randomizes the order in which tests run, it can be "off", "on" or an integer used as seed.
+	// When "on", the seed is based on the current time and printed so that the order can be reproduced.
+	// Test steps are shuffled only in tests allowing it.
+	// +kubebuilder:validation:Pattern:=`^(off|on|-?[0-9]+)$`
+	// +optional
+	Shuffle string `json:"shuffle,omitempty"`
+
 	// TestFile is the name of the file containing the test to run.
 	// +kubebuilder:default:="chainsaw-test.yaml"
 	// +optional
@@ -74,6 +121,23 @@ type ConfigurationSpec struct {
 	// +optional
 	ForceTerminationGracePeriod *metav1.Duration `json:"forceTerminationGracePeriod,omitempty"`
 
+	// ServerSideApply determines whether apply operations use server-side apply by default.
+	// +optional
+	ServerSideApply bool `json:"serverSideApply,omitempty"`
+
+	// FieldManager is the name of the field manager used by server-side apply. It defaults to "chainsaw".
+	// +optional
+	// +kubebuilder:default:="chainsaw"
+	FieldManager string `json:"fieldManager,omitempty"`
+
+	// ForceConflicts determines whether server-side apply takes ownership of conflicting fields by default.
+	// +optional
+	ForceConflicts bool `json:"forceConflicts,omitempty"`
+
+	// Template determines whether resources should be considered for templating.
+	// +optional
+	Template bool `json:"template,omitempty"`
+
 	// DelayBeforeCleanup adds a delay between the time a test ends and the time cleanup starts.
 	// +optional
 	DelayBeforeCleanup *metav1.Duration `json:"delayBeforeCleanup,omitempty"`
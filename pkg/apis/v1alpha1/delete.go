@@ -13,6 +13,11 @@ type Delete struct {
 	// ObjectReference determines objects to be deleted.
 	ObjectReference `json:"ref"`
 
+	// Template determines whether the object reference should be considered for templating.
+	// Overrides the setting in the Test and the global setting in the Configuration.
+	// +optional
+	Template *bool `json:"template,omitempty"`
+
 	// Expect defines a list of matched checks to validate the operation outcome.
 	// +optional
 	Expect []Expectation `json:"expect,omitempty"`
@@ -0,0 +1,15 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Describe defines how to describe objects along with their related events.
+type Describe struct {
+	// Timeout for the operation. Overrides the global timeout set in the Configuration.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// ObjectReference determines objects to describe.
+	ObjectReference `json:"ref"`
+}
@@ -11,6 +11,16 @@ type Error struct {
 	// +optional
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 
+	// Polling defines how resources are polled by the operation.
+	// Overrides the polling set in the step, in the Test and the global polling set in the Configuration.
+	// +optional
+	Polling *Polling `json:"polling,omitempty"`
+
 	// FileRefOrResource provides a reference to the expected error.
 	FileRefOrResource `json:",inline"`
+
+	// Template determines whether resources should be considered for templating.
+	// Overrides the setting in the Test and the global setting in the Configuration.
+	// +optional
+	Template *bool `json:"template,omitempty"`
 }
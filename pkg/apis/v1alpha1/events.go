@@ -23,4 +23,24 @@ type Events struct {
 	// Selector defines labels selector.
 	// +optional
 	Selector string `json:"selector,omitempty"`
+
+	// InvolvedObject filters events by the object they relate to.
+	// +optional
+	InvolvedObject *InvolvedObject `json:"involvedObject,omitempty"`
+
+	// Type filters events by type (Normal or Warning).
+	// +optional
+	// +kubebuilder:validation:Enum=Normal;Warning;
+	Type string `json:"type,omitempty"`
+}
+
+// InvolvedObject identifies the object events relate to.
+type InvolvedObject struct {
+	// Kind of the involved object.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Name of the involved object.
+	// +optional
+	Name string `json:"name,omitempty"`
 }
@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Exec describes a command to execute in a pod container.
+type Exec struct {
+	// Timeout for the operation. Overrides the global timeout set in the Configuration.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Namespace of the pod, it defaults to the test namespace.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the pod.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Selector defines labels selector, the command is executed in the first running pod matching the selector.
+	// +optional
+	Selector string `json:"selector,omitempty"`
+
+	// Container in pod to execute the command in, it defaults to the default container of the pod.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// Entrypoint is the command entry point to run.
+	Entrypoint string `json:"entrypoint"`
+
+	// Args is the command arguments.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// SkipLogOutput removes the output from the command. Useful for sensitive logs or to reduce noise.
+	// +optional
+	SkipLogOutput bool `json:"skipLogOutput,omitempty"`
+
+	// Check is an assertion tree to validate the operation outcome.
+	// +optional
+	Check *Check `json:"check,omitempty"`
+
+	// Outputs defines output bindings.
+	// +optional
+	Outputs []Output `json:"outputs,omitempty"`
+}
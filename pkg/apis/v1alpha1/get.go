@@ -0,0 +1,15 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Get defines how to collect objects and log them as YAML.
+type Get struct {
+	// Timeout for the operation. Overrides the global timeout set in the Configuration.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// ObjectReference determines objects to collect.
+	ObjectReference `json:"ref"`
+}
@@ -0,0 +1,93 @@
+package v1alpha1
+
+import (
+	"github.com/kyverno/kyverno-json/pkg/apis/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HTTP describes an HTTP request to send, it is retried until the check passes or the operation times out.
+type HTTP struct {
+	// Timeout for the operation. Overrides the global timeout set in the Configuration.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// URL of the request. When a target is set, only the path and query of the URL are used.
+	URL string `json:"url"`
+
+	// Target determines the in-cluster service or pod the request is sent to.
+	// +optional
+	Target *HTTPTarget `json:"target,omitempty"`
+
+	// Method of the request, it defaults to GET.
+	// +optional
+	// +kubebuilder:validation:Enum=GET;HEAD;POST;PUT;PATCH;DELETE;OPTIONS
+	Method string `json:"method,omitempty"`
+
+	// Headers of the request.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body of the request. Strings are sent as is, other values are sent as JSON.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Body *v1alpha1.Any `json:"body,omitempty"`
+
+	// TLS defines the TLS configuration used to connect to the server.
+	// +optional
+	TLS *HTTPTLS `json:"tls,omitempty"`
+
+	// Polling determines the delay between two attempts.
+	// +optional
+	Polling *Polling `json:"polling,omitempty"`
+
+	// Check is an assertion tree to validate the operation outcome.
+	// When no check is set, the response status code must be lower than 400.
+	// +optional
+	Check *Check `json:"check,omitempty"`
+
+	// Outputs defines output bindings.
+	// +optional
+	Outputs []Output `json:"outputs,omitempty"`
+}
+
+// HTTPTarget represents an in-cluster service or pod.
+type HTTPTarget struct {
+	// Kind of the target.
+	// +kubebuilder:validation:Enum=Service;Pod
+	Kind string `json:"kind"`
+
+	// Namespace of the target, it defaults to the test namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the target.
+	Name string `json:"name"`
+
+	// Port of the service or the pod.
+	Port int32 `json:"port"`
+
+	// Scheme used to reach the target, it defaults to http.
+	// +optional
+	// +kubebuilder:validation:Enum=http;https
+	Scheme string `json:"scheme,omitempty"`
+
+	// Proxy determines whether the request is sent through the API server proxy instead of a port-forward.
+	// +optional
+	Proxy bool `json:"proxy,omitempty"`
+}
+
+// HTTPTLS defines the TLS configuration used to connect to a server.
+type HTTPTLS struct {
+	// InsecureSkipVerify disables the verification of the server certificate.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+
+	// CA is the path to a PEM encoded CA bundle used to verify the server certificate, relative to the test folder.
+	// +optional
+	CA string `json:"ca,omitempty"`
+
+	// ServerName is used to verify the server certificate, useful when the request goes through a port-forward.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+}
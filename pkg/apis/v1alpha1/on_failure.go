@@ -0,0 +1,25 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OnFailure defines actions to be executed when a test fails.
+type OnFailure struct {
+	// Dump determines how the cluster state is collected when a test fails.
+	// +optional
+	Dump *Dump `json:"dump,omitempty"`
+}
+
+// Dump defines how the cluster state is collected when a test fails.
+// It contains all objects in the test namespace (except secrets), events, pod logs and pod descriptions,
+// as well as the cluster scoped objects created by the test.
+type Dump struct {
+	// Timeout for the dump. Overrides the global exec timeout set in the Configuration.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Tail is the number of last lines to collect from pod containers. If omitted, all lines are collected.
+	// +optional
+	Tail *int `json:"tail,omitempty"`
+}
@@ -11,6 +11,10 @@ type Operation struct {
 	// +optional
 	ContinueOnError *bool `json:"continueOnError,omitempty"`
 
+	// Bindings defines additional binding key/values.
+	// +optional
+	Bindings []Binding `json:"bindings,omitempty"`
+
 	// Apply represents resources that should be applied for this test step. This can include things
 	// like configuration settings or any other resources that need to be available during the test.
 	// +optional
@@ -37,6 +41,18 @@ type Operation struct {
 	// +optional
 	Error *Error `json:"error,omitempty"`
 
+	// Exec defines a command to execute in a pod container.
+	// +optional
+	Exec *Exec `json:"exec,omitempty"`
+
+	// HTTP defines an HTTP request to send.
+	// +optional
+	HTTP *HTTP `json:"http,omitempty"`
+
+	// Patch represents a patch operation on an existing object.
+	// +optional
+	Patch *Patch `json:"patch,omitempty"`
+
 	// Script defines a script to run.
 	// +optional
 	Script *Script `json:"script,omitempty"`
@@ -44,4 +60,8 @@ type Operation struct {
 	// Sleep defines zzzz.
 	// +optional
 	Sleep *Sleep `json:"sleep,omitempty"`
+
+	// Wait represents a wait for a condition, a predicate or the deletion of objects.
+	// +optional
+	Wait *Wait `json:"wait,omitempty"`
 }
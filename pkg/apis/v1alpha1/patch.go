@@ -0,0 +1,43 @@
+package v1alpha1
+
+import (
+	"github.com/kyverno/kyverno-json/pkg/apis/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type PatchType string
+
+const (
+	JSONPatch           PatchType = "json"
+	MergePatch          PatchType = "merge"
+	StrategicMergePatch PatchType = "strategic"
+)
+
+// Patch represents a patch to be applied to an existing object.
+type Patch struct {
+	// Timeout for the operation. Overrides the global timeout set in the Configuration.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// ObjectReference determines objects to be patched.
+	ObjectReference `json:"ref"`
+
+	// Type determines the patch strategy (json|merge|strategic), it defaults to merge.
+	// +optional
+	// +kubebuilder:validation:Enum=json;merge;strategic
+	Type PatchType `json:"type,omitempty"`
+
+	// Patch contains the patch content. It must be a list of operations for json patches,
+	// or a partial object for merge and strategic merge patches.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Patch v1alpha1.Any `json:"patch"`
+
+	// DryRun determines whether the patch should be applied in dry run mode.
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Expect defines a list of matched checks to validate the operation outcome.
+	// +optional
+	Expect []Expectation `json:"expect,omitempty"`
+}
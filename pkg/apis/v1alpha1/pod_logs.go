@@ -33,4 +33,12 @@ type PodLogs struct {
 	// This matches default behavior of `kubectl logs`.
 	// +optional
 	Tail *int `json:"tail,omitempty"`
+
+	// Previous determines whether logs of the previous instance of the containers should be collected.
+	// +optional
+	Previous bool `json:"previous,omitempty"`
+
+	// Since only collects logs newer than a relative duration like 5s, 2m, or 3h.
+	// +optional
+	Since *metav1.Duration `json:"since,omitempty"`
 }
@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	DefaultPollInterval       = 50 * time.Millisecond
+	DefaultBackoffFactor      = 2
+	DefaultBackoffMaxInterval = 5 * time.Second
+)
+
+// Polling defines how resources are polled while an operation waits for them.
+type Polling struct {
+	// Interval defines the delay between two attempts, it is the initial delay when a backoff is configured.
+	// It defaults to 50ms.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Backoff defines an exponential backoff policy applied to the interval.
+	// +optional
+	Backoff *Backoff `json:"backoff,omitempty"`
+}
+
+// Backoff defines an exponential backoff policy.
+type Backoff struct {
+	// Factor defines the multiplier applied to the interval after every attempt. It defaults to 2.
+	// +kubebuilder:validation:Format:=int
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	Factor *int `json:"factor,omitempty"`
+
+	// MaxInterval defines the maximum delay between two attempts. It defaults to 5s.
+	// +optional
+	MaxInterval *metav1.Duration `json:"maxInterval,omitempty"`
+}
+
+func (p Polling) IntervalDuration() time.Duration {
+	return durationOrDefault(p.Interval, DefaultPollInterval)
+}
+
+func (b Backoff) FactorValue() int {
+	if b.Factor != nil {
+		return *b.Factor
+	}
+	return DefaultBackoffFactor
+}
+
+func (b Backoff) MaxIntervalDuration() time.Duration {
+	return durationOrDefault(b.MaxInterval, DefaultBackoffMaxInterval)
+}
+
+func (p Polling) Combine(override *Polling) Polling {
+	if override == nil {
+		return p
+	}
+	if override.Interval != nil {
+		p.Interval = override.Interval
+	}
+	if override.Backoff != nil {
+		p.Backoff = override.Backoff
+	}
+	return p
+}
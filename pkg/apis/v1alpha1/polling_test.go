@@ -0,0 +1,62 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestPolling_Defaults(t *testing.T) {
+	var polling Polling
+	assert.Equal(t, DefaultPollInterval, polling.IntervalDuration())
+	var backoff Backoff
+	assert.Equal(t, DefaultBackoffFactor, backoff.FactorValue())
+	assert.Equal(t, DefaultBackoffMaxInterval, backoff.MaxIntervalDuration())
+}
+
+func TestPolling_NotDefaults(t *testing.T) {
+	polling := Polling{
+		Interval: &metav1.Duration{Duration: time.Second},
+	}
+	assert.Equal(t, time.Second, polling.IntervalDuration())
+	backoff := Backoff{
+		Factor:      ptr.To(3),
+		MaxInterval: &metav1.Duration{Duration: time.Minute},
+	}
+	assert.Equal(t, 3, backoff.FactorValue())
+	assert.Equal(t, time.Minute, backoff.MaxIntervalDuration())
+}
+
+func TestPolling_Combine(t *testing.T) {
+	interval := &metav1.Duration{Duration: time.Second}
+	override := &metav1.Duration{Duration: time.Minute}
+	backoff := &Backoff{Factor: ptr.To(3)}
+	tests := []struct {
+		name     string
+		base     Polling
+		override *Polling
+		want     Polling
+	}{{
+		name: "nil",
+		base: Polling{Interval: interval},
+		want: Polling{Interval: interval},
+	}, {
+		name:     "interval",
+		base:     Polling{Interval: interval, Backoff: backoff},
+		override: &Polling{Interval: override},
+		want:     Polling{Interval: override, Backoff: backoff},
+	}, {
+		name:     "backoff",
+		base:     Polling{Interval: interval},
+		override: &Polling{Backoff: backoff},
+		want:     Polling{Interval: interval, Backoff: backoff},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.base.Combine(tt.override))
+		})
+	}
+}
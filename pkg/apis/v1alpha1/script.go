@@ -21,4 +21,8 @@ type Script struct {
 	// Check is an assertion tree to validate the operation outcome.
 	// +optional
 	Check *Check `json:"check,omitempty"`
+
+	// Outputs defines output bindings.
+	// +optional
+	Outputs []Output `json:"outputs,omitempty"`
 }
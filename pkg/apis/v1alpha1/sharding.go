@@ -0,0 +1,19 @@
+package v1alpha1
+
+// Sharding defines how tests are split across multiple runs.
+type Sharding struct {
+	// Index is the zero based index of the shard to run.
+	// +kubebuilder:validation:Format:=int
+	// +kubebuilder:validation:Minimum:=0
+	Index int `json:"index"`
+
+	// Count is the total number of shards.
+	// +kubebuilder:validation:Format:=int
+	// +kubebuilder:validation:Minimum:=1
+	Count int `json:"count"`
+
+	// DurationsReport is the path to a JSON report of a previous run.
+	// When set, tests are distributed across shards based on their durations instead of round robin.
+	// +optional
+	DurationsReport string `json:"durationsReport,omitempty"`
+}
@@ -6,6 +6,28 @@ import (
 
 // Sleep represents a duration while nothing happens.
 type Sleep struct {
-	// Duration is the delay used for sleeping.
+	// Duration is the delay used for sleeping, it is the maximum delay when until is set.
 	Duration metav1.Duration `json:"duration"`
+
+	// Until stops sleeping early when a predicate evaluates to true for the referenced objects.
+	// +optional
+	Until *SleepUntil `json:"until,omitempty"`
+}
+
+// SleepUntil represents a predicate that stops sleeping early.
+type SleepUntil struct {
+	// ObjectReference determines objects the predicate is evaluated against.
+	ObjectReference `json:"ref"`
+
+	// Predicate is a JMESPath expression evaluated against each object, sleeping stops when it evaluates to `true` for all of them.
+	Predicate string `json:"predicate"`
+
+	// Template determines whether the object reference should be considered for templating.
+	// Overrides the setting in the Test and the global setting in the Configuration.
+	// +optional
+	Template *bool `json:"template,omitempty"`
+
+	// Polling overrides the polling configuration when the objects can't be watched.
+	// +optional
+	Polling *Polling `json:"polling,omitempty"`
 }
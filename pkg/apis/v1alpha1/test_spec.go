@@ -14,6 +14,10 @@ type TestSpec struct {
 	// +optional
 	Timeouts *Timeouts `json:"timeouts,omitempty"`
 
+	// Polling for the test. Overrides the global polling set in the Configuration.
+	// +optional
+	Polling *Polling `json:"polling,omitempty"`
+
 	// Skip determines whether the test should skipped.
 	// +optional
 	Skip *bool `json:"skip,omitempty"`
@@ -26,10 +30,30 @@ type TestSpec struct {
 	// +optional
 	SkipDelete *bool `json:"skipDelete,omitempty"`
 
+	// Retries indicates how many times the test is retried if it fails.
+	// Overrides the global setting in the Configuration.
+	// +kubebuilder:validation:Format:=int
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	Retries *int `json:"retries,omitempty"`
+
+	// ShuffleSteps determines whether the steps of the test can run in a random order when shuffling is enabled.
+	// +optional
+	ShuffleSteps bool `json:"shuffleSteps,omitempty"`
+
 	// Namespace determines whether the test should run in a random ephemeral namespace or not.
 	// +optional
 	Namespace string `json:"namespace,omitempty"`
 
+	// Template determines whether resources should be considered for templating.
+	// Overrides the global setting in the Configuration.
+	// +optional
+	Template *bool `json:"template,omitempty"`
+
+	// Bindings defines additional binding key/values.
+	// +optional
+	Bindings []Binding `json:"bindings,omitempty"`
+
 	// Steps defining the test.
 	Steps []TestSpecStep `json:"steps"`
 
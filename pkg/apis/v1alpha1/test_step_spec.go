@@ -10,10 +10,18 @@ type TestStepSpec struct {
 	// +optional
 	Timeouts *Timeouts `json:"timeouts,omitempty"`
 
+	// Polling for the test step. Overrides the global polling set in the Configuration and the polling eventually set in the Test.
+	// +optional
+	Polling *Polling `json:"polling,omitempty"`
+
 	// SkipDelete determines whether the resources created by the step should be deleted after the test step is executed.
 	// +optional
 	SkipDelete *bool `json:"skipDelete,omitempty"`
 
+	// Bindings defines additional binding key/values.
+	// +optional
+	Bindings []Binding `json:"bindings,omitempty"`
+
 	// Try defines what the step will try to execute.
 	Try []Operation `json:"try"`
 
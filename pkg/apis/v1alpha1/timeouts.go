@@ -13,6 +13,7 @@ const (
 	DefaultDeleteTimeout  = 15 * time.Second
 	DefaultErrorTimeout   = 30 * time.Second
 	DefaultExecTimeout    = 5 * time.Second
+	DefaultWaitTimeout    = 30 * time.Second
 )
 
 // Timeouts contains timeouts per operation.
@@ -34,6 +35,9 @@ type Timeouts struct {
 
 	// Exec defines the timeout for exec operations
 	Exec *metav1.Duration `json:"exec,omitempty"`
+
+	// Wait defines the timeout for the wait operation
+	Wait *metav1.Duration `json:"wait,omitempty"`
 }
 
 func durationOrDefault(to *metav1.Duration, def time.Duration) time.Duration {
@@ -67,6 +71,10 @@ func (t Timeouts) ExecDuration() time.Duration {
 	return durationOrDefault(t.Exec, DefaultExecTimeout)
 }
 
+func (t Timeouts) WaitDuration() time.Duration {
+	return durationOrDefault(t.Wait, DefaultWaitTimeout)
+}
+
 func (t Timeouts) Combine(override *Timeouts) Timeouts {
 	if override == nil {
 		return t
@@ -89,5 +97,8 @@ func (t Timeouts) Combine(override *Timeouts) Timeouts {
 	if override.Exec != nil {
 		t.Exec = override.Exec
 	}
+	if override.Wait != nil {
+		t.Wait = override.Wait
+	}
 	return t
 }
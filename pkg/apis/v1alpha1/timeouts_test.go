@@ -41,6 +41,7 @@ func TestTimeouts_Defaults(t *testing.T) {
 	assert.Equal(t, DefaultDeleteTimeout, timeouts.DeleteDuration())
 	assert.Equal(t, DefaultErrorTimeout, timeouts.ErrorDuration())
 	assert.Equal(t, DefaultExecTimeout, timeouts.ExecDuration())
+	assert.Equal(t, DefaultWaitTimeout, timeouts.WaitDuration())
 }
 
 func TestTimeouts_NoyDefaults(t *testing.T) {
@@ -52,6 +53,7 @@ func TestTimeouts_NoyDefaults(t *testing.T) {
 		Delete:  to,
 		Error:   to,
 		Exec:    to,
+		Wait:    to,
 	}
 	assert.Equal(t, time.Hour*2, timeouts.ApplyDuration())
 	assert.Equal(t, time.Hour*2, timeouts.AssertDuration())
@@ -59,6 +61,7 @@ func TestTimeouts_NoyDefaults(t *testing.T) {
 	assert.Equal(t, time.Hour*2, timeouts.DeleteDuration())
 	assert.Equal(t, time.Hour*2, timeouts.ErrorDuration())
 	assert.Equal(t, time.Hour*2, timeouts.ExecDuration())
+	assert.Equal(t, time.Hour*2, timeouts.WaitDuration())
 }
 
 func TestTimeouts_Combine(t *testing.T) {
@@ -69,6 +72,7 @@ func TestTimeouts_Combine(t *testing.T) {
 		Delete:  &metav1.Duration{Duration: 1 * time.Minute},
 		Error:   &metav1.Duration{Duration: 1 * time.Minute},
 		Exec:    &metav1.Duration{Duration: 1 * time.Minute},
+		Wait:    &metav1.Duration{Duration: 1 * time.Minute},
 	}
 	override := Timeouts{
 		Apply:   &metav1.Duration{Duration: 2 * time.Minute},
@@ -77,6 +81,7 @@ func TestTimeouts_Combine(t *testing.T) {
 		Delete:  &metav1.Duration{Duration: 2 * time.Minute},
 		Error:   &metav1.Duration{Duration: 2 * time.Minute},
 		Exec:    &metav1.Duration{Duration: 2 * time.Minute},
+		Wait:    &metav1.Duration{Duration: 2 * time.Minute},
 	}
 	tests := []struct {
 		name     string
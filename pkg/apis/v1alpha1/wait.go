@@ -0,0 +1,35 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	WaitForDelete          = "delete"
+	WaitForConditionPrefix = "condition="
+	WaitForJMESPathPrefix  = "jmespath="
+)
+
+// Wait represents a wait for a condition, a predicate or the deletion of one or more objects.
+type Wait struct {
+	// Timeout for the operation. Overrides the global timeout set in the Configuration.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// ObjectReference determines objects to wait for.
+	ObjectReference `json:"ref"`
+
+	// For determines what to wait for, it can be `delete`, `condition=<type>` (optionally followed by `=<status>`,
+	// status defaults to `True`) or `jmespath=<expression>` where the expression must evaluate to `true`.
+	// +kubebuilder:validation:Pattern:=`^(delete|condition=.+|jmespath=.+)$`
+	For string `json:"for"`
+
+	// Template determines whether the object reference should be considered for templating.
+	// Overrides the setting in the Test and the global setting in the Configuration.
+	// +optional
+	Template *bool `json:"template,omitempty"`
+
+	// Polling overrides the polling configuration when the objects can't be watched.
+	// +optional
+	Polling *Polling `json:"polling,omitempty"`
+}
@@ -34,12 +34,32 @@ func (in *Apply) DeepCopyInto(out *Apply) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Polling != nil {
+		in, out := &in.Polling, &out.Polling
+		*out = new(Polling)
+		(*in).DeepCopyInto(*out)
+	}
 	in.FileRefOrResource.DeepCopyInto(&out.FileRefOrResource)
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DryRun != nil {
 		in, out := &in.DryRun, &out.DryRun
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ServerSide != nil {
+		in, out := &in.ServerSide, &out.ServerSide
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForceConflicts != nil {
+		in, out := &in.ForceConflicts, &out.ForceConflicts
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Expect != nil {
 		in, out := &in.Expect, &out.Expect
 		*out = make([]Expectation, len(*in))
@@ -47,6 +67,13 @@ func (in *Apply) DeepCopyInto(out *Apply) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]Output, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -68,7 +95,24 @@ func (in *Assert) DeepCopyInto(out *Assert) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Polling != nil {
+		in, out := &in.Polling, &out.Polling
+		*out = new(Polling)
+		(*in).DeepCopyInto(*out)
+	}
 	in.FileRefOrResource.DeepCopyInto(&out.FileRefOrResource)
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]Output, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -82,6 +126,49 @@ func (in *Assert) DeepCopy() *Assert {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Backoff) DeepCopyInto(out *Backoff) {
+	*out = *in
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Backoff.
+func (in *Backoff) DeepCopy() *Backoff {
+	if in == nil {
+		return nil
+	}
+	out := new(Backoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Binding) DeepCopyInto(out *Binding) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Binding.
+func (in *Binding) DeepCopy() *Binding {
+	if in == nil {
+		return nil
+	}
+	out := new(Binding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Catch) DeepCopyInto(out *Catch) {
 	*out = *in
@@ -95,6 +182,16 @@ func (in *Catch) DeepCopyInto(out *Catch) {
 		*out = new(Events)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Get != nil {
+		in, out := &in.Get, &out.Get
+		*out = new(Get)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Describe != nil {
+		in, out := &in.Describe, &out.Describe
+		*out = new(Describe)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Command != nil {
 		in, out := &in.Command, &out.Command
 		*out = new(Command)
@@ -108,7 +205,7 @@ func (in *Catch) DeepCopyInto(out *Catch) {
 	if in.Sleep != nil {
 		in, out := &in.Sleep, &out.Sleep
 		*out = new(Sleep)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -140,6 +237,13 @@ func (in *Command) DeepCopyInto(out *Command) {
 		in, out := &in.Check, &out.Check
 		*out = (*in).DeepCopy()
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]Output, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -184,16 +288,36 @@ func (in *Configuration) DeepCopyObject() runtime.Object {
 func (in *ConfigurationSpec) DeepCopyInto(out *ConfigurationSpec) {
 	*out = *in
 	in.Timeouts.DeepCopyInto(&out.Timeouts)
+	if in.Polling != nil {
+		in, out := &in.Polling, &out.Polling
+		*out = new(Polling)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Parallel != nil {
 		in, out := &in.Parallel, &out.Parallel
 		*out = new(int)
 		**out = **in
 	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = new(OnFailure)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sharding != nil {
+		in, out := &in.Sharding, &out.Sharding
+		*out = new(Sharding)
+		**out = **in
+	}
 	if in.RepeatCount != nil {
 		in, out := &in.RepeatCount, &out.RepeatCount
 		*out = new(int)
 		**out = **in
 	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(int)
+		**out = **in
+	}
 	if in.ForceTerminationGracePeriod != nil {
 		in, out := &in.ForceTerminationGracePeriod, &out.ForceTerminationGracePeriod
 		*out = new(v1.Duration)
@@ -225,7 +349,17 @@ func (in *Create) DeepCopyInto(out *Create) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Polling != nil {
+		in, out := &in.Polling, &out.Polling
+		*out = new(Polling)
+		(*in).DeepCopyInto(*out)
+	}
 	in.FileRefOrResource.DeepCopyInto(&out.FileRefOrResource)
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DryRun != nil {
 		in, out := &in.DryRun, &out.DryRun
 		*out = new(bool)
@@ -260,6 +394,11 @@ func (in *Delete) DeepCopyInto(out *Delete) {
 		**out = **in
 	}
 	in.ObjectReference.DeepCopyInto(&out.ObjectReference)
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Expect != nil {
 		in, out := &in.Expect, &out.Expect
 		*out = make([]Expectation, len(*in))
@@ -280,6 +419,54 @@ func (in *Delete) DeepCopy() *Delete {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Describe) DeepCopyInto(out *Describe) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.ObjectReference.DeepCopyInto(&out.ObjectReference)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Describe.
+func (in *Describe) DeepCopy() *Describe {
+	if in == nil {
+		return nil
+	}
+	out := new(Describe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dump) DeepCopyInto(out *Dump) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Tail != nil {
+		in, out := &in.Tail, &out.Tail
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dump.
+func (in *Dump) DeepCopy() *Dump {
+	if in == nil {
+		return nil
+	}
+	out := new(Dump)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Error) DeepCopyInto(out *Error) {
 	*out = *in
@@ -288,7 +475,17 @@ func (in *Error) DeepCopyInto(out *Error) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Polling != nil {
+		in, out := &in.Polling, &out.Polling
+		*out = new(Polling)
+		(*in).DeepCopyInto(*out)
+	}
 	in.FileRefOrResource.DeepCopyInto(&out.FileRefOrResource)
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -310,6 +507,11 @@ func (in *Events) DeepCopyInto(out *Events) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.InvolvedObject != nil {
+		in, out := &in.InvolvedObject, &out.InvolvedObject
+		*out = new(InvolvedObject)
+		**out = **in
+	}
 	return
 }
 
@@ -323,6 +525,43 @@ func (in *Events) DeepCopy() *Events {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Exec) DeepCopyInto(out *Exec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Check != nil {
+		in, out := &in.Check, &out.Check
+		*out = (*in).DeepCopy()
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]Output, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Exec.
+func (in *Exec) DeepCopy() *Exec {
+	if in == nil {
+		return nil
+	}
+	out := new(Exec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Expectation) DeepCopyInto(out *Expectation) {
 	*out = *in
@@ -394,6 +633,16 @@ func (in *Finally) DeepCopyInto(out *Finally) {
 		*out = new(Events)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Get != nil {
+		in, out := &in.Get, &out.Get
+		*out = new(Get)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Describe != nil {
+		in, out := &in.Describe, &out.Describe
+		*out = new(Describe)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Command != nil {
 		in, out := &in.Command, &out.Command
 		*out = new(Command)
@@ -407,7 +656,7 @@ func (in *Finally) DeepCopyInto(out *Finally) {
 	if in.Sleep != nil {
 		in, out := &in.Sleep, &out.Sleep
 		*out = new(Sleep)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -422,6 +671,134 @@ func (in *Finally) DeepCopy() *Finally {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Get) DeepCopyInto(out *Get) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.ObjectReference.DeepCopyInto(&out.ObjectReference)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Get.
+func (in *Get) DeepCopy() *Get {
+	if in == nil {
+		return nil
+	}
+	out := new(Get)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTP) DeepCopyInto(out *HTTP) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(HTTPTarget)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = (*in).DeepCopy()
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(HTTPTLS)
+		**out = **in
+	}
+	if in.Polling != nil {
+		in, out := &in.Polling, &out.Polling
+		*out = new(Polling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Check != nil {
+		in, out := &in.Check, &out.Check
+		*out = (*in).DeepCopy()
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]Output, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTP.
+func (in *HTTP) DeepCopy() *HTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTLS) DeepCopyInto(out *HTTPTLS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTLS.
+func (in *HTTPTLS) DeepCopy() *HTTPTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTarget) DeepCopyInto(out *HTTPTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTarget.
+func (in *HTTPTarget) DeepCopy() *HTTPTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvolvedObject) DeepCopyInto(out *InvolvedObject) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvolvedObject.
+func (in *InvolvedObject) DeepCopy() *InvolvedObject {
+	if in == nil {
+		return nil
+	}
+	out := new(InvolvedObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectReference) DeepCopyInto(out *ObjectReference) {
 	*out = *in
@@ -462,6 +839,27 @@ func (in *ObjectSelector) DeepCopy() *ObjectSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnFailure) DeepCopyInto(out *OnFailure) {
+	*out = *in
+	if in.Dump != nil {
+		in, out := &in.Dump, &out.Dump
+		*out = new(Dump)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnFailure.
+func (in *OnFailure) DeepCopy() *OnFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(OnFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Operation) DeepCopyInto(out *Operation) {
 	*out = *in
@@ -470,6 +868,13 @@ func (in *Operation) DeepCopyInto(out *Operation) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]Binding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Apply != nil {
 		in, out := &in.Apply, &out.Apply
 		*out = new(Apply)
@@ -500,6 +905,21 @@ func (in *Operation) DeepCopyInto(out *Operation) {
 		*out = new(Error)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(Exec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patch != nil {
+		in, out := &in.Patch, &out.Patch
+		*out = new(Patch)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Script != nil {
 		in, out := &in.Script, &out.Script
 		*out = new(Script)
@@ -508,7 +928,12 @@ func (in *Operation) DeepCopyInto(out *Operation) {
 	if in.Sleep != nil {
 		in, out := &in.Sleep, &out.Sleep
 		*out = new(Sleep)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Wait != nil {
+		in, out := &in.Wait, &out.Wait
+		*out = new(Wait)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -523,6 +948,62 @@ func (in *Operation) DeepCopy() *Operation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Output) DeepCopyInto(out *Output) {
+	*out = *in
+	in.Binding.DeepCopyInto(&out.Binding)
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Output.
+func (in *Output) DeepCopy() *Output {
+	if in == nil {
+		return nil
+	}
+	out := new(Output)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Patch) DeepCopyInto(out *Patch) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.ObjectReference.DeepCopyInto(&out.ObjectReference)
+	in.Patch.DeepCopyInto(&out.Patch)
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Expect != nil {
+		in, out := &in.Expect, &out.Expect
+		*out = make([]Expectation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Patch.
+func (in *Patch) DeepCopy() *Patch {
+	if in == nil {
+		return nil
+	}
+	out := new(Patch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodLogs) DeepCopyInto(out *PodLogs) {
 	*out = *in
@@ -536,6 +1017,11 @@ func (in *PodLogs) DeepCopyInto(out *PodLogs) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Since != nil {
+		in, out := &in.Since, &out.Since
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -549,6 +1035,32 @@ func (in *PodLogs) DeepCopy() *PodLogs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Polling) DeepCopyInto(out *Polling) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Polling.
+func (in *Polling) DeepCopy() *Polling {
+	if in == nil {
+		return nil
+	}
+	out := new(Polling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Script) DeepCopyInto(out *Script) {
 	*out = *in
@@ -561,6 +1073,13 @@ func (in *Script) DeepCopyInto(out *Script) {
 		in, out := &in.Check, &out.Check
 		*out = (*in).DeepCopy()
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]Output, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -574,10 +1093,31 @@ func (in *Script) DeepCopy() *Script {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Sharding) DeepCopyInto(out *Sharding) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sharding.
+func (in *Sharding) DeepCopy() *Sharding {
+	if in == nil {
+		return nil
+	}
+	out := new(Sharding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sleep) DeepCopyInto(out *Sleep) {
 	*out = *in
 	out.Duration = in.Duration
+	if in.Until != nil {
+		in, out := &in.Until, &out.Until
+		*out = new(SleepUntil)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -591,6 +1131,33 @@ func (in *Sleep) DeepCopy() *Sleep {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SleepUntil) DeepCopyInto(out *SleepUntil) {
+	*out = *in
+	in.ObjectReference.DeepCopyInto(&out.ObjectReference)
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Polling != nil {
+		in, out := &in.Polling, &out.Polling
+		*out = new(Polling)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SleepUntil.
+func (in *SleepUntil) DeepCopy() *SleepUntil {
+	if in == nil {
+		return nil
+	}
+	out := new(SleepUntil)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Test) DeepCopyInto(out *Test) {
 	*out = *in
@@ -626,6 +1193,11 @@ func (in *TestSpec) DeepCopyInto(out *TestSpec) {
 		*out = new(Timeouts)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Polling != nil {
+		in, out := &in.Polling, &out.Polling
+		*out = new(Polling)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Skip != nil {
 		in, out := &in.Skip, &out.Skip
 		*out = new(bool)
@@ -641,6 +1213,23 @@ func (in *TestSpec) DeepCopyInto(out *TestSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(int)
+		**out = **in
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]Binding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Steps != nil {
 		in, out := &in.Steps, &out.Steps
 		*out = make([]TestSpecStep, len(*in))
@@ -723,11 +1312,23 @@ func (in *TestStepSpec) DeepCopyInto(out *TestStepSpec) {
 		*out = new(Timeouts)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Polling != nil {
+		in, out := &in.Polling, &out.Polling
+		*out = new(Polling)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SkipDelete != nil {
 		in, out := &in.SkipDelete, &out.SkipDelete
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]Binding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Try != nil {
 		in, out := &in.Try, &out.Try
 		*out = make([]Operation, len(*in))
@@ -795,6 +1396,11 @@ func (in *Timeouts) DeepCopyInto(out *Timeouts) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Wait != nil {
+		in, out := &in.Wait, &out.Wait
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -807,3 +1413,35 @@ func (in *Timeouts) DeepCopy() *Timeouts {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Wait) DeepCopyInto(out *Wait) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	in.ObjectReference.DeepCopyInto(&out.ObjectReference)
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Polling != nil {
+		in, out := &in.Polling, &out.Polling
+		*out = new(Polling)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Wait.
+func (in *Wait) DeepCopy() *Wait {
+	if in == nil {
+		return nil
+	}
+	out := new(Wait)
+	in.DeepCopyInto(out)
+	return out
+}
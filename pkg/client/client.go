@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -30,11 +31,14 @@ type Client interface {
 	// struct pointer so that obj can be updated with the content returned by the Server.
 	Patch(ctx context.Context, obj ctrlclient.Object, patch ctrlclient.Patch, opts ...ctrlclient.PatchOption) error
 
+	// Watch watches objects of the type of the given list, filtered with the list options.
+	Watch(ctx context.Context, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) (watch.Interface, error)
+
 	// IsObjectNamespaced returns true if the GroupVersionKind of the object is namespaced.
 	IsObjectNamespaced(obj runtime.Object) (bool, error)
 }
 
 func New(cfg *rest.Config) (Client, error) {
 	var opts ctrlclient.Options
-	return ctrlclient.New(cfg, opts)
+	return ctrlclient.NewWithWatch(cfg, opts)
 }
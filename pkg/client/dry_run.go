@@ -5,6 +5,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -36,6 +37,10 @@ func (c *dryRunClient) Patch(ctx context.Context, obj client.Object, patch clien
 	return c.inner.Patch(ctx, obj, patch, append(opts, client.DryRunAll)...)
 }
 
+func (c *dryRunClient) Watch(ctx context.Context, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+	return c.inner.Watch(ctx, list, opts...)
+}
+
 func DryRun(inner Client) Client {
 	return &dryRunClient{inner: inner}
 }
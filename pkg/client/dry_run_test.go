@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -432,3 +433,72 @@ func Test_dryRunClient_IsObjectNamespaced(t *testing.T) {
 		})
 	}
 }
+
+func Test_dryRunClient_Watch(t *testing.T) {
+	tests := []struct {
+		name    string
+		inner   Client
+		obj     client.ObjectList
+		opts    []client.ListOption
+		wantErr bool
+	}{{
+		name:    "no error",
+		obj:     nil,
+		opts:    nil,
+		wantErr: false,
+	}, {
+		name:    "error",
+		obj:     nil,
+		opts:    nil,
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantErr := func() error {
+				if tt.wantErr {
+					return errors.New("dummy error")
+				}
+				return nil
+			}
+			inner := &tclient.FakeClient{
+				GetFn: func(ctx context.Context, call int, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+					assert.NotContains(t, opts, client.DryRunAll)
+					return wantErr()
+				},
+				CreateFn: func(ctx context.Context, call int, obj ctrlclient.Object, opts ...ctrlclient.CreateOption) error {
+					assert.Contains(t, opts, client.DryRunAll)
+					return wantErr()
+				},
+				DeleteFn: func(ctx context.Context, call int, obj ctrlclient.Object, opts ...ctrlclient.DeleteOption) error {
+					assert.Contains(t, opts, client.DryRunAll)
+					return wantErr()
+				},
+				ListFn: func(ctx context.Context, call int, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) error {
+					assert.NotContains(t, opts, client.DryRunAll)
+					return wantErr()
+				},
+				PatchFn: func(ctx context.Context, call int, obj ctrlclient.Object, patch ctrlclient.Patch, opts ...ctrlclient.PatchOption) error {
+					assert.Contains(t, opts, client.DryRunAll)
+					return wantErr()
+				},
+				WatchFn: func(ctx context.Context, call int, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) (watch.Interface, error) {
+					assert.NotContains(t, opts, client.DryRunAll)
+					return watch.NewFake(), wantErr()
+				},
+				IsObjectNamespacedFn: func(call int, obj runtime.Object) (bool, error) {
+					return false, wantErr()
+				},
+			}
+			c := &dryRunClient{
+				inner: inner,
+			}
+			_, err := c.Watch(context.TODO(), tt.obj, tt.opts...)
+			assert.Equal(t, 1, inner.NumCalls())
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// RunIDLabel identifies the chainsaw run that created an object.
+	RunIDLabel = "chainsaw.kyverno.io/run-id"
+	// TestLabel identifies the test that created an object.
+	TestLabel = "chainsaw.kyverno.io/test"
+	// CreatedAtLabel contains the unix time (in seconds) at which an object was created.
+	CreatedAtLabel = "chainsaw.kyverno.io/created-at"
+)
+
+var invalidLabelValueChars = regexp.MustCompile(`[^-A-Za-z0-9_.]+`)
+
+// LabelValue converts value into a valid label value.
+func LabelValue(value string) string {
+	value = invalidLabelValueChars.ReplaceAllString(value, "-")
+	if len(value) > validation.LabelValueMaxLength {
+		value = value[:validation.LabelValueMaxLength]
+	}
+	return strings.Trim(value, "-_.")
+}
+
+type labelsClient struct {
+	inner  Client
+	labels map[string]string
+}
+
+func (c *labelsClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	c.addLabels(obj)
+	return c.inner.Create(ctx, obj, opts...)
+}
+
+func (c *labelsClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.inner.Delete(ctx, obj, opts...)
+}
+
+func (c *labelsClient) Get(ctx context.Context, key types.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+	return c.inner.Get(ctx, key, obj, opts...)
+}
+
+func (c *labelsClient) IsObjectNamespaced(obj runtime.Object) (bool, error) {
+	return c.inner.IsObjectNamespaced(obj)
+}
+
+func (c *labelsClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.inner.List(ctx, list, opts...)
+}
+
+func (c *labelsClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	// server-side apply can create objects, label them only if they don't exist yet
+	if patch.Type() == types.ApplyPatchType {
+		var actual unstructured.Unstructured
+		actual.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+		if err := c.inner.Get(ctx, ObjectKey(obj), &actual); err != nil {
+			if !kerrors.IsNotFound(err) {
+				return err
+			}
+			c.addLabels(obj)
+		}
+	}
+	return c.inner.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *labelsClient) Watch(ctx context.Context, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+	return c.inner.Watch(ctx, list, opts...)
+}
+
+func (c *labelsClient) addLabels(obj client.Object) {
+	if obj == nil || len(c.labels) == 0 {
+		return
+	}
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for key, value := range c.labels {
+		if _, ok := labels[key]; !ok {
+			labels[key] = value
+		}
+	}
+	obj.SetLabels(labels)
+}
+
+// WithLabels returns a client adding labels to the objects it creates, existing labels are not overridden.
+func WithLabels(inner Client, labels map[string]string) Client {
+	return &labelsClient{inner: inner, labels: labels}
+}
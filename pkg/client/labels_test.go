@@ -0,0 +1,160 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tclient "github.com/kyverno/chainsaw/pkg/client/testing"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestLabelValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{{
+		name:  "empty",
+		value: "",
+		want:  "",
+	}, {
+		name:  "valid",
+		value: "my-test_1.0",
+		want:  "my-test_1.0",
+	}, {
+		name:  "invalid chars",
+		value: "my test/with:chars",
+		want:  "my-test-with-chars",
+	}, {
+		name:  "trim",
+		value: "/my-test/",
+		want:  "my-test",
+	}, {
+		name:  "too long",
+		value: strings.Repeat("a", 70),
+		want:  strings.Repeat("a", 63),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LabelValue(tt.value)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWithLabels(t *testing.T) {
+	labels := map[string]string{RunIDLabel: "abc"}
+	got := WithLabels(nil, labels)
+	assert.Equal(t, &labelsClient{labels: labels}, got)
+}
+
+func Test_labelsClient_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		obj     map[string]string
+		want    map[string]string
+		wantErr bool
+	}{{
+		name:   "no labels",
+		labels: map[string]string{RunIDLabel: "abc"},
+		obj:    nil,
+		want:   map[string]string{RunIDLabel: "abc"},
+	}, {
+		name:   "merge labels",
+		labels: map[string]string{RunIDLabel: "abc"},
+		obj:    map[string]string{"foo": "bar"},
+		want:   map[string]string{"foo": "bar", RunIDLabel: "abc"},
+	}, {
+		name:   "don't override",
+		labels: map[string]string{RunIDLabel: "abc", TestLabel: "test"},
+		obj:    map[string]string{TestLabel: "mine"},
+		want:   map[string]string{RunIDLabel: "abc", TestLabel: "mine"},
+	}, {
+		name:    "error",
+		labels:  map[string]string{RunIDLabel: "abc"},
+		want:    map[string]string{RunIDLabel: "abc"},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var obj unstructured.Unstructured
+			obj.SetLabels(tt.obj)
+			inner := &tclient.FakeClient{
+				CreateFn: func(ctx context.Context, call int, obj ctrlclient.Object, opts ...ctrlclient.CreateOption) error {
+					assert.Equal(t, tt.want, obj.GetLabels())
+					if tt.wantErr {
+						return errors.New("dummy error")
+					}
+					return nil
+				},
+			}
+			err := WithLabels(inner, tt.labels).Create(context.TODO(), &obj)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_labelsClient_Patch(t *testing.T) {
+	notFound := kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "foo")
+	tests := []struct {
+		name    string
+		patch   ctrlclient.Patch
+		getErr  error
+		want    map[string]string
+		wantErr bool
+	}{{
+		name:  "merge patch",
+		patch: ctrlclient.RawPatch(types.MergePatchType, nil),
+		want:  nil,
+	}, {
+		name:   "apply existing object",
+		patch:  ctrlclient.Apply,
+		getErr: nil,
+		want:   nil,
+	}, {
+		name:   "apply new object",
+		patch:  ctrlclient.Apply,
+		getErr: notFound,
+		want:   map[string]string{RunIDLabel: "abc"},
+	}, {
+		name:    "get error",
+		patch:   ctrlclient.Apply,
+		getErr:  errors.New("dummy error"),
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var obj unstructured.Unstructured
+			obj.SetAPIVersion("v1")
+			obj.SetKind("ConfigMap")
+			obj.SetName("foo")
+			inner := &tclient.FakeClient{
+				GetFn: func(ctx context.Context, call int, key types.NamespacedName, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+					return tt.getErr
+				},
+				PatchFn: func(ctx context.Context, call int, obj ctrlclient.Object, patch ctrlclient.Patch, opts ...ctrlclient.PatchOption) error {
+					assert.Equal(t, tt.want, obj.GetLabels())
+					return nil
+				},
+			}
+			err := WithLabels(inner, map[string]string{RunIDLabel: "abc"}).Patch(context.TODO(), &obj, tt.patch)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
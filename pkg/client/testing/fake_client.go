@@ -2,8 +2,10 @@ package testing
 
 import (
 	"context"
+	"errors"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -14,6 +16,7 @@ type FakeClient struct {
 	DeleteFn             func(ctx context.Context, call int, obj ctrlclient.Object, opts ...ctrlclient.DeleteOption) error
 	ListFn               func(ctx context.Context, call int, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) error
 	PatchFn              func(ctx context.Context, call int, obj ctrlclient.Object, patch ctrlclient.Patch, opts ...ctrlclient.PatchOption) error
+	WatchFn              func(ctx context.Context, call int, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) (watch.Interface, error)
 	IsObjectNamespacedFn func(call int, obj runtime.Object) (bool, error)
 	numCalls             int
 }
@@ -43,6 +46,16 @@ func (c *FakeClient) Patch(ctx context.Context, obj ctrlclient.Object, patch ctr
 	return c.PatchFn(ctx, c.numCalls, obj, patch, opts...)
 }
 
+// Watch returns an error without counting the call when WatchFn is not set,
+// this lets callers fall back to polling transparently.
+func (c *FakeClient) Watch(ctx context.Context, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) (watch.Interface, error) {
+	if c.WatchFn == nil {
+		return nil, errors.New("watch not supported")
+	}
+	defer func() { c.numCalls++ }()
+	return c.WatchFn(ctx, c.numCalls, list, opts...)
+}
+
 func (c *FakeClient) IsObjectNamespaced(obj runtime.Object) (bool, error) {
 	defer func() { c.numCalls++ }()
 	return c.IsObjectNamespacedFn(c.numCalls, obj)
@@ -0,0 +1,185 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/kyverno/chainsaw/pkg/client"
+	restutils "github.com/kyverno/chainsaw/pkg/utils/rest"
+	"github.com/spf13/cobra"
+	"go.uber.org/multierr"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/clock"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// skipped contains the resources that are never swept, events are owned by the api server.
+var skipped = []schema.GroupResource{
+	{Group: "", Resource: "events"},
+	{Group: "events.k8s.io", Resource: "events"},
+}
+
+type options struct {
+	olderThan           metav1.Duration
+	runID               string
+	dryRun              bool
+	kubeConfigOverrides clientcmd.ConfigOverrides
+}
+
+func Command() *cobra.Command {
+	var options options
+	cmd := &cobra.Command{
+		Use:          "cleanup [flags]...",
+		Short:        "Delete leftover resources created by chainsaw",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := restutils.Config(options.kubeConfigOverrides)
+			if err != nil {
+				return err
+			}
+			c, err := client.New(cfg)
+			if err != nil {
+				return err
+			}
+			d, err := discovery.NewDiscoveryClientForConfig(cfg)
+			if err != nil {
+				return err
+			}
+			return sweep(cmd.Context(), cmd.OutOrStdout(), c, d, clock.RealClock{}, options)
+		},
+	}
+	cmd.Flags().DurationVar(&options.olderThan.Duration, "older-than", time.Hour, "Only delete resources created before this duration")
+	cmd.Flags().StringVar(&options.runID, "run-id", "", "Only delete resources created by the given run")
+	cmd.Flags().BoolVar(&options.dryRun, "dry-run", false, "List resources that would be deleted without deleting them")
+	clientcmd.BindOverrideFlags(&options.kubeConfigOverrides, cmd.Flags(), clientcmd.RecommendedConfigOverrideFlags("kube-"))
+	return cmd
+}
+
+func sweep(ctx context.Context, out io.Writer, c client.Client, d discovery.DiscoveryInterface, clock clock.PassiveClock, options options) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	fmt.Fprintln(out, "Looking for leftover resources...")
+	resources, err := list(ctx, c, d, options.runID)
+	if err != nil && len(resources) == 0 {
+		return err
+	}
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+	cutoff := clock.Now().Add(-options.olderThan.Duration)
+	namespaces := map[string]bool{}
+	var candidates []unstructured.Unstructured
+	for _, resource := range resources {
+		createdAt, err := strconv.ParseInt(resource.GetLabels()[client.CreatedAtLabel], 10, 64)
+		if err != nil {
+			// resources without a creation time are ignored, chainsaw always sets it
+			continue
+		}
+		if !time.Unix(createdAt, 0).Before(cutoff) {
+			continue
+		}
+		if isNamespace(resource) {
+			namespaces[resource.GetName()] = true
+		}
+		candidates = append(candidates, resource)
+	}
+	// deleting a namespace deletes its content, namespaces are deleted last
+	var toDelete []unstructured.Unstructured
+	for _, candidate := range candidates {
+		if candidate.GetNamespace() != "" && namespaces[candidate.GetNamespace()] {
+			continue
+		}
+		toDelete = append(toDelete, candidate)
+	}
+	sort.SliceStable(toDelete, func(i, j int) bool {
+		return !isNamespace(toDelete[i]) && isNamespace(toDelete[j])
+	})
+	if len(toDelete) == 0 {
+		fmt.Fprintln(out, "No leftover resources found.")
+		return multierr.Combine(errs...)
+	}
+	for _, resource := range toDelete {
+		labels := resource.GetLabels()
+		fmt.Fprintf(out, "- %s %s (run: %s, test: %s)", resource.GetKind(), describe(resource), labels[client.RunIDLabel], labels[client.TestLabel])
+		if options.dryRun {
+			fmt.Fprintln(out, " - DRY RUN")
+			continue
+		}
+		resource := resource
+		if err := c.Delete(ctx, &resource, ctrlclient.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !kerrors.IsNotFound(err) {
+			fmt.Fprintf(out, " - ERROR (%s)\n", err)
+			errs = append(errs, err)
+			continue
+		}
+		fmt.Fprintln(out, " - DELETED")
+	}
+	fmt.Fprintln(out, "Done.")
+	return multierr.Combine(errs...)
+}
+
+func list(ctx context.Context, c client.Client, d discovery.DiscoveryInterface, runID string) ([]unstructured.Unstructured, error) {
+	lists, err := d.ServerPreferredResources()
+	// discovery can return partial results when some groups are not available
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+	var opts []ctrlclient.ListOption
+	if runID != "" {
+		opts = append(opts, ctrlclient.MatchingLabels{client.RunIDLabel: runID})
+	} else {
+		opts = append(opts, ctrlclient.HasLabels{client.RunIDLabel})
+	}
+	var resources []unstructured.Unstructured
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, resource := range list.APIResources {
+			if !slices.Contains(resource.Verbs, "list") || !slices.Contains(resource.Verbs, "delete") {
+				continue
+			}
+			if slices.Contains(skipped, gv.WithResource(resource.Name).GroupResource()) {
+				continue
+			}
+			var items unstructured.UnstructuredList
+			items.SetGroupVersionKind(gv.WithKind(resource.Kind + "List"))
+			if err := c.List(ctx, &items, opts...); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			resources = append(resources, items.Items...)
+		}
+	}
+	return resources, multierr.Combine(errs...)
+}
+
+func isNamespace(resource unstructured.Unstructured) bool {
+	gvk := resource.GroupVersionKind()
+	return gvk.Group == "" && gvk.Kind == "Namespace"
+}
+
+func describe(resource unstructured.Unstructured) string {
+	if resource.GetNamespace() == "" {
+		return resource.GetName()
+	}
+	return resource.GetNamespace() + "/" + resource.GetName()
+}
@@ -0,0 +1,226 @@
+package cleanup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/kyverno/chainsaw/pkg/client"
+	tclient "github.com/kyverno/chainsaw/pkg/client/testing"
+	"github.com/kyverno/chainsaw/pkg/commands/root"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/discovery"
+	tclock "k8s.io/utils/clock/testing"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_Execute(t *testing.T) {
+	basePath := "../../../testdata/commands/cleanup"
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		out     string
+	}{{
+		name: "help",
+		args: []string{
+			"cleanup",
+			"--help",
+		},
+		out:     filepath.Join(basePath, "help.txt"),
+		wantErr: false,
+	}, {
+		name: "unknow flag",
+		args: []string{
+			"cleanup",
+			"--foo",
+		},
+		wantErr: true,
+	}, {
+		name: "unknow arg",
+		args: []string{
+			"cleanup",
+			"foo",
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := root.Command()
+			cmd.AddCommand(Command())
+			assert.NotNil(t, cmd)
+			cmd.SetArgs(tt.args)
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			err := cmd.Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			actual, err := io.ReadAll(out)
+			assert.NoError(t, err)
+			if tt.out != "" {
+				expected, err := os.ReadFile(tt.out)
+				assert.NoError(t, err)
+				assert.Equal(t, string(expected), string(actual))
+			}
+		})
+	}
+}
+
+type fakeDiscovery struct {
+	discovery.DiscoveryInterface
+	resources []*metav1.APIResourceList
+	err       error
+}
+
+func (d *fakeDiscovery) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	return d.resources, d.err
+}
+
+func object(apiVersion, kind, namespace, name string, createdAt time.Time) unstructured.Unstructured {
+	var obj unstructured.Unstructured
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(map[string]string{
+		client.RunIDLabel:     "abc",
+		client.TestLabel:      "test",
+		client.CreatedAtLabel: strconv.FormatInt(createdAt.Unix(), 10),
+	})
+	return obj
+}
+
+func Test_sweep(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-2 * time.Hour)
+	resources := []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{
+			Name:  "namespaces",
+			Kind:  "Namespace",
+			Verbs: []string{"list", "delete"},
+		}, {
+			Name:       "configmaps",
+			Namespaced: true,
+			Kind:       "ConfigMap",
+			Verbs:      []string{"list", "delete"},
+		}, {
+			Name:       "events",
+			Namespaced: true,
+			Kind:       "Event",
+			Verbs:      []string{"list", "delete"},
+		}, {
+			Name:  "componentstatuses",
+			Kind:  "ComponentStatus",
+			Verbs: []string{"list"},
+		}},
+	}}
+	objects := map[string][]unstructured.Unstructured{
+		"NamespaceList": {
+			object("v1", "Namespace", "", "chainsaw-old", old),
+			object("v1", "Namespace", "", "chainsaw-new", now),
+		},
+		"ConfigMapList": {
+			object("v1", "ConfigMap", "chainsaw-old", "in-old-namespace", old),
+			object("v1", "ConfigMap", "default", "old", old),
+			object("v1", "ConfigMap", "default", "new", now),
+		},
+	}
+	tests := []struct {
+		name          string
+		options       options
+		discoveryErr  error
+		deleteErr     error
+		wantErr       bool
+		expectedOut   string
+		expectedList  []string
+		expectedCalls []string
+	}{{
+		name:    "delete",
+		options: options{olderThan: metav1.Duration{Duration: time.Hour}},
+		expectedOut: `Looking for leftover resources...
+- ConfigMap default/old (run: abc, test: test) - DELETED
+- Namespace chainsaw-old (run: abc, test: test) - DELETED
+Done.
+`,
+		expectedList:  []string{"NamespaceList", "ConfigMapList"},
+		expectedCalls: []string{"default/old", "chainsaw-old"},
+	}, {
+		name:    "dry run",
+		options: options{olderThan: metav1.Duration{Duration: time.Hour}, dryRun: true},
+		expectedOut: `Looking for leftover resources...
+- ConfigMap default/old (run: abc, test: test) - DRY RUN
+- Namespace chainsaw-old (run: abc, test: test) - DRY RUN
+Done.
+`,
+		expectedList: []string{"NamespaceList", "ConfigMapList"},
+	}, {
+		name:    "nothing to delete",
+		options: options{olderThan: metav1.Duration{Duration: 3 * time.Hour}},
+		expectedOut: `Looking for leftover resources...
+No leftover resources found.
+`,
+		expectedList: []string{"NamespaceList", "ConfigMapList"},
+	}, {
+		name:      "delete error",
+		options:   options{olderThan: metav1.Duration{Duration: time.Hour}},
+		deleteErr: errors.New("dummy error"),
+		wantErr:   true,
+		expectedOut: `Looking for leftover resources...
+- ConfigMap default/old (run: abc, test: test) - ERROR (dummy error)
+- Namespace chainsaw-old (run: abc, test: test) - ERROR (dummy error)
+Done.
+`,
+		expectedList:  []string{"NamespaceList", "ConfigMapList"},
+		expectedCalls: []string{"default/old", "chainsaw-old"},
+	}, {
+		name:         "discovery error",
+		discoveryErr: errors.New("dummy error"),
+		wantErr:      true,
+		expectedOut: `Looking for leftover resources...
+`,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var listed []string
+			var deleted []string
+			c := &tclient.FakeClient{
+				ListFn: func(ctx context.Context, call int, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) error {
+					kind := list.GetObjectKind().GroupVersionKind().Kind
+					listed = append(listed, kind)
+					var options ctrlclient.ListOptions
+					options.ApplyOptions(opts)
+					assert.Equal(t, client.RunIDLabel, options.LabelSelector.String())
+					list.(*unstructured.UnstructuredList).Items = objects[kind]
+					return nil
+				},
+				DeleteFn: func(ctx context.Context, call int, obj ctrlclient.Object, opts ...ctrlclient.DeleteOption) error {
+					deleted = append(deleted, describe(*obj.(*unstructured.Unstructured)))
+					return tt.deleteErr
+				},
+			}
+			d := &fakeDiscovery{resources: resources, err: tt.discoveryErr}
+			out := bytes.NewBufferString("")
+			err := sweep(context.TODO(), out, c, d, tclock.NewFakePassiveClock(now), tt.options)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOut, out.String())
+			assert.Equal(t, tt.expectedList, listed)
+			assert.Equal(t, tt.expectedCalls, deleted)
+		})
+	}
+}
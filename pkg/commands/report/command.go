@@ -0,0 +1,22 @@
+package report
+
+import (
+	"github.com/kyverno/chainsaw/pkg/commands/report/merge"
+	"github.com/spf13/cobra"
+)
+
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "report",
+		Short:        "Report commands",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+	}
+	cmd.AddCommand(
+		merge.Command(),
+	)
+	return cmd
+}
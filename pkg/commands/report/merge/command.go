@@ -0,0 +1,58 @@
+package merge
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/report"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	reportFormat string
+	reportName   string
+}
+
+func Command() *cobra.Command {
+	var options options
+	cmd := &cobra.Command{
+		Use:          "merge [flags]... [json reports]...",
+		Short:        "Merge JSON reports into a single report",
+		Args:         cobra.MinimumNArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			format := v1alpha1.ReportFormatType(options.reportFormat)
+			// fail early if the format is not supported
+			if _, err := report.GetSerializer(format); err != nil {
+				return err
+			}
+			output, err := filepath.Abs(report.FilePath(format, options.reportName))
+			if err != nil {
+				return err
+			}
+			var reports []*report.TestsReport
+			for _, path := range args {
+				if input, err := filepath.Abs(path); err == nil && input == output {
+					return fmt.Errorf("merged report %s would overwrite one of the reports to merge", report.FilePath(format, options.reportName))
+				}
+				loaded, err := report.Load(path)
+				if err != nil {
+					return fmt.Errorf("failed to load report %s (%w)", path, err)
+				}
+				fmt.Fprintf(out, "- %s (%d tests)\n", path, len(loaded.Reports))
+				reports = append(reports, loaded)
+			}
+			merged := report.Merge(options.reportName, reports...)
+			if err := merged.SaveReportBasedOnType(format, options.reportName); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "Merged %d reports (%d tests, %d failures)\n", len(reports), len(merged.Reports), merged.Failures)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&options.reportFormat, "report-format", string(v1alpha1.JSONFormat), "Merged report format (JSON|XML|JUNIT|HTML|MARKDOWN)")
+	cmd.Flags().StringVar(&options.reportName, "report-name", "chainsaw-merged-report", "The name of the merged report to create")
+	return cmd
+}
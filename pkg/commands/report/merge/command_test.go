@@ -0,0 +1,125 @@
+package merge
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyverno/chainsaw/pkg/commands/root"
+	"github.com/kyverno/chainsaw/pkg/report"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Execute(t *testing.T) {
+	basePath := "../../../../testdata/commands/report/merge"
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+		out     string
+	}{{
+		name: "help",
+		args: []string{
+			"merge",
+			"--help",
+		},
+		out:     filepath.Join(basePath, "help.txt"),
+		wantErr: false,
+	}, {
+		name: "no reports",
+		args: []string{
+			"merge",
+		},
+		wantErr: true,
+	}, {
+		name: "missing report",
+		args: []string{
+			"merge",
+			filepath.Join(basePath, "missing.json"),
+		},
+		wantErr: true,
+	}, {
+		name: "unsupported format",
+		args: []string{
+			"merge",
+			"--report-format=YAML",
+			filepath.Join(basePath, "shard-1.json"),
+		},
+		wantErr: true,
+	}, {
+		name: "overwrite input",
+		args: []string{
+			"merge",
+			"--report-name=" + filepath.Join(basePath, "shard-1"),
+			filepath.Join(basePath, "shard-1.json"),
+			filepath.Join(basePath, "shard-2.json"),
+		},
+		wantErr: true,
+	}, {
+		name: "unknow flag",
+		args: []string{
+			"merge",
+			"--foo",
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := root.Command()
+			cmd.AddCommand(Command())
+			assert.NotNil(t, cmd)
+			cmd.SetArgs(tt.args)
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			err := cmd.Execute()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			actual, err := io.ReadAll(out)
+			assert.NoError(t, err)
+			if tt.out != "" {
+				expected, err := os.ReadFile(tt.out)
+				assert.NoError(t, err)
+				assert.Equal(t, string(expected), string(actual))
+			}
+		})
+	}
+}
+
+func Test_Merge(t *testing.T) {
+	basePath := "../../../../testdata/commands/report/merge"
+	for _, format := range []string{"JSON", "XML", "JUNIT", "HTML", "MARKDOWN"} {
+		t.Run(format, func(t *testing.T) {
+			reportName := filepath.Join(t.TempDir(), "merged")
+			cmd := root.Command()
+			cmd.AddCommand(Command())
+			cmd.SetArgs([]string{
+				"merge",
+				"--report-format=" + format,
+				"--report-name=" + reportName,
+				filepath.Join(basePath, "shard-1.json"),
+				filepath.Join(basePath, "shard-2.json"),
+			})
+			out := bytes.NewBufferString("")
+			cmd.SetOut(out)
+			assert.NoError(t, cmd.Execute())
+			assert.Contains(t, out.String(), "Merged 2 reports (3 tests, 1 failures)\n")
+			matches, err := filepath.Glob(reportName + ".*")
+			assert.NoError(t, err)
+			assert.Len(t, matches, 1)
+			if format == "JSON" {
+				merged, err := report.Load(matches[0])
+				assert.NoError(t, err)
+				assert.Equal(t, reportName, merged.Name)
+				assert.Equal(t, "25.000", merged.Time)
+				assert.Equal(t, 3, merged.Test)
+				assert.Equal(t, 1, merged.Failures)
+				assert.Len(t, merged.Reports, 3)
+			}
+		})
+	}
+}
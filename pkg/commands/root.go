@@ -1,11 +1,13 @@
 package commands
 
 import (
+	"github.com/kyverno/chainsaw/pkg/commands/cleanup"
 	"github.com/kyverno/chainsaw/pkg/commands/create"
 	"github.com/kyverno/chainsaw/pkg/commands/docs"
 	"github.com/kyverno/chainsaw/pkg/commands/export"
 	"github.com/kyverno/chainsaw/pkg/commands/generate"
 	"github.com/kyverno/chainsaw/pkg/commands/migrate"
+	"github.com/kyverno/chainsaw/pkg/commands/report"
 	"github.com/kyverno/chainsaw/pkg/commands/root"
 	"github.com/kyverno/chainsaw/pkg/commands/test"
 	"github.com/kyverno/chainsaw/pkg/commands/version"
@@ -15,11 +17,13 @@ import (
 func RootCommand() *cobra.Command {
 	cmd := root.Command()
 	cmd.AddCommand(
+		cleanup.Command(),
 		create.Command(),
 		docs.Command(),
 		export.Command(),
 		generate.Command(),
 		migrate.Command(),
+		report.Command(),
 		test.Command(),
 		version.Command(),
 	)
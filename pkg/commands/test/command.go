@@ -6,12 +6,16 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/config"
 	"github.com/kyverno/chainsaw/pkg/data"
 	"github.com/kyverno/chainsaw/pkg/discovery"
+	"github.com/kyverno/chainsaw/pkg/report"
 	"github.com/kyverno/chainsaw/pkg/runner"
 	flagutils "github.com/kyverno/chainsaw/pkg/utils/flag"
 	fsutils "github.com/kyverno/chainsaw/pkg/utils/fs"
@@ -34,21 +38,39 @@ type options struct {
 	deleteTimeout               metav1.Duration
 	cleanupTimeout              metav1.Duration
 	execTimeout                 metav1.Duration
+	waitTimeout                 metav1.Duration
+	pollInterval                metav1.Duration
+	pollBackoffFactor           int
+	pollBackoffMaxInterval      metav1.Duration
 	testDirs                    []string
 	skipDelete                  bool
 	failFast                    bool
 	parallel                    int
 	repeatCount                 int
+	retries                     int
+	failOnFlaky                 bool
+	shuffle                     string
 	reportFormat                string
 	reportName                  string
+	githubStepSummary           bool
+	artifactsDir                string
+	dumpOnFailure               bool
 	namespace                   string
 	fullName                    bool
 	excludeTestRegex            string
 	includeTestRegex            string
+	shardIndex                  int
+	shardCount                  int
+	shardDurations              string
+	rerunFailed                 string
 	noColor                     bool
 	kubeConfigOverrides         clientcmd.ConfigOverrides
 	forceTerminationGracePeriod metav1.Duration
 	delayBeforeCleanup          metav1.Duration
+	serverSideApply             bool
+	fieldManager                string
+	forceConflicts              bool
+	template                    bool
 	selector                    []string
 }
 
@@ -115,6 +137,26 @@ func Command() *cobra.Command {
 			if flagutils.IsSet(flags, "exec-timeout") {
 				configuration.Spec.Timeouts.Exec = &options.execTimeout
 			}
+			if flagutils.IsSet(flags, "wait-timeout") {
+				configuration.Spec.Timeouts.Wait = &options.waitTimeout
+			}
+			if (flagutils.IsSet(flags, "poll-interval") || flagutils.IsSet(flags, "poll-backoff-factor") || flagutils.IsSet(flags, "poll-backoff-max-interval")) && configuration.Spec.Polling == nil {
+				configuration.Spec.Polling = &v1alpha1.Polling{}
+			}
+			if flagutils.IsSet(flags, "poll-interval") {
+				configuration.Spec.Polling.Interval = &options.pollInterval
+			}
+			if flagutils.IsSet(flags, "poll-backoff-factor") || flagutils.IsSet(flags, "poll-backoff-max-interval") {
+				if configuration.Spec.Polling.Backoff == nil {
+					configuration.Spec.Polling.Backoff = &v1alpha1.Backoff{}
+				}
+				if flagutils.IsSet(flags, "poll-backoff-factor") {
+					configuration.Spec.Polling.Backoff.Factor = &options.pollBackoffFactor
+				}
+				if flagutils.IsSet(flags, "poll-backoff-max-interval") {
+					configuration.Spec.Polling.Backoff.MaxInterval = &options.pollBackoffMaxInterval
+				}
+			}
 			if flagutils.IsSet(flags, "skip-delete") {
 				configuration.Spec.SkipDelete = options.skipDelete
 			}
@@ -127,12 +169,39 @@ func Command() *cobra.Command {
 			if flagutils.IsSet(flags, "repeat-count") {
 				configuration.Spec.RepeatCount = &options.repeatCount
 			}
+			if flagutils.IsSet(flags, "retries") {
+				configuration.Spec.Retries = &options.retries
+			}
+			if flagutils.IsSet(flags, "fail-on-flaky") {
+				configuration.Spec.FailOnFlaky = options.failOnFlaky
+			}
+			if flagutils.IsSet(flags, "shuffle") {
+				configuration.Spec.Shuffle = options.shuffle
+			}
 			if flagutils.IsSet(flags, "report-format") {
 				configuration.Spec.ReportFormat = v1alpha1.ReportFormatType(options.reportFormat)
 			}
 			if flagutils.IsSet(flags, "report-name") {
 				configuration.Spec.ReportName = options.reportName
 			}
+			if flagutils.IsSet(flags, "github-step-summary") {
+				configuration.Spec.GitHubStepSummary = options.githubStepSummary
+			}
+			if flagutils.IsSet(flags, "artifacts-dir") {
+				configuration.Spec.ArtifactsDir = options.artifactsDir
+			}
+			if flagutils.IsSet(flags, "dump-on-failure") {
+				if options.dumpOnFailure {
+					if configuration.Spec.OnFailure == nil {
+						configuration.Spec.OnFailure = &v1alpha1.OnFailure{}
+					}
+					if configuration.Spec.OnFailure.Dump == nil {
+						configuration.Spec.OnFailure.Dump = &v1alpha1.Dump{}
+					}
+				} else if configuration.Spec.OnFailure != nil {
+					configuration.Spec.OnFailure.Dump = nil
+				}
+			}
 			if flagutils.IsSet(flags, "namespace") {
 				configuration.Spec.Namespace = options.namespace
 			}
@@ -145,15 +214,54 @@ func Command() *cobra.Command {
 			if flagutils.IsSet(flags, "exclude-test-regex") {
 				configuration.Spec.ExcludeTestRegex = options.excludeTestRegex
 			}
+			if flagutils.IsSet(flags, "shard-index") || flagutils.IsSet(flags, "shard-count") || flagutils.IsSet(flags, "shard-durations") {
+				if configuration.Spec.Sharding == nil {
+					configuration.Spec.Sharding = &v1alpha1.Sharding{Count: 1}
+				}
+				if flagutils.IsSet(flags, "shard-index") {
+					configuration.Spec.Sharding.Index = options.shardIndex
+				}
+				if flagutils.IsSet(flags, "shard-count") {
+					configuration.Spec.Sharding.Count = options.shardCount
+				}
+				if flagutils.IsSet(flags, "shard-durations") {
+					configuration.Spec.Sharding.DurationsReport = options.shardDurations
+				}
+			}
+			if flagutils.IsSet(flags, "rerun-failed") {
+				configuration.Spec.RerunFailed = options.rerunFailed
+			}
 			if flagutils.IsSet(flags, "force-termination-grace-period") {
 				configuration.Spec.ForceTerminationGracePeriod = &options.forceTerminationGracePeriod
 			}
 			if flagutils.IsSet(flags, "cleanup-delay") {
 				configuration.Spec.DelayBeforeCleanup = &options.delayBeforeCleanup
 			}
+			if flagutils.IsSet(flags, "server-side-apply") {
+				configuration.Spec.ServerSideApply = options.serverSideApply
+			}
+			if flagutils.IsSet(flags, "field-manager") {
+				configuration.Spec.FieldManager = options.fieldManager
+			}
+			if flagutils.IsSet(flags, "force-conflicts") {
+				configuration.Spec.ForceConflicts = options.forceConflicts
+			}
+			if flagutils.IsSet(flags, "template") {
+				configuration.Spec.Template = options.template
+			}
 			if len(options.testDirs) == 0 {
 				options.testDirs = append(options.testDirs, ".")
 			}
+			// resolve the shuffle seed so that it can be printed and reused
+			switch configuration.Spec.Shuffle {
+			case "", "off":
+			case "on":
+				configuration.Spec.Shuffle = strconv.FormatInt(clock.Now().UnixNano(), 10)
+			default:
+				if _, err := strconv.ParseInt(configuration.Spec.Shuffle, 10, 64); err != nil {
+					return fmt.Errorf("invalid shuffle value %q, must be off, on or an integer", configuration.Spec.Shuffle)
+				}
+			}
 			fmt.Fprintf(out, "- Using test file: %s\n", configuration.Spec.TestFile)
 			fmt.Fprintf(out, "- TestDirs %v\n", options.testDirs)
 			fmt.Fprintf(out, "- SkipDelete %v\n", configuration.Spec.SkipDelete)
@@ -170,21 +278,65 @@ func Command() *cobra.Command {
 			fmt.Fprintf(out, "- DeleteTimeout %v\n", configuration.Spec.Timeouts.DeleteDuration())
 			fmt.Fprintf(out, "- ErrorTimeout %v\n", configuration.Spec.Timeouts.ErrorDuration())
 			fmt.Fprintf(out, "- ExecTimeout %v\n", configuration.Spec.Timeouts.ExecDuration())
+			fmt.Fprintf(out, "- WaitTimeout %v\n", configuration.Spec.Timeouts.WaitDuration())
+			if configuration.Spec.Polling != nil {
+				fmt.Fprintf(out, "- PollInterval %v\n", configuration.Spec.Polling.IntervalDuration())
+				if configuration.Spec.Polling.Backoff != nil {
+					fmt.Fprintf(out, "- PollBackoffFactor %v\n", configuration.Spec.Polling.Backoff.FactorValue())
+					fmt.Fprintf(out, "- PollBackoffMaxInterval %v\n", configuration.Spec.Polling.Backoff.MaxIntervalDuration())
+				}
+			}
 			if configuration.Spec.Parallel != nil && *configuration.Spec.Parallel > 0 {
 				fmt.Fprintf(out, "- Parallel %d\n", *configuration.Spec.Parallel)
 			}
 			if configuration.Spec.RepeatCount != nil {
 				fmt.Fprintf(out, "- RepeatCount %v\n", *configuration.Spec.RepeatCount)
 			}
+			if configuration.Spec.Retries != nil {
+				fmt.Fprintf(out, "- Retries %v\n", *configuration.Spec.Retries)
+			}
+			if configuration.Spec.FailOnFlaky {
+				fmt.Fprintf(out, "- FailOnFlaky %v\n", configuration.Spec.FailOnFlaky)
+			}
+			if configuration.Spec.Shuffle != "" && configuration.Spec.Shuffle != "off" {
+				fmt.Fprintf(out, "- Shuffle %v\n", configuration.Spec.Shuffle)
+			}
 			if configuration.Spec.ForceTerminationGracePeriod != nil {
 				fmt.Fprintf(out, "- ForceTerminationGracePeriod %v\n", configuration.Spec.ForceTerminationGracePeriod.Duration)
 			}
 			if configuration.Spec.DelayBeforeCleanup != nil {
 				fmt.Fprintf(out, "- DelayBeforeCleanup %v\n", configuration.Spec.DelayBeforeCleanup.Duration)
 			}
+			if configuration.Spec.ServerSideApply {
+				fmt.Fprintf(out, "- ServerSideApply %v\n", configuration.Spec.ServerSideApply)
+				fmt.Fprintf(out, "- FieldManager '%v'\n", configuration.Spec.FieldManager)
+				fmt.Fprintf(out, "- ForceConflicts %v\n", configuration.Spec.ForceConflicts)
+			}
+			if configuration.Spec.Template {
+				fmt.Fprintf(out, "- Template %v\n", configuration.Spec.Template)
+			}
+			if configuration.Spec.GitHubStepSummary {
+				fmt.Fprintf(out, "- GitHubStepSummary %v\n", configuration.Spec.GitHubStepSummary)
+			}
+			if configuration.Spec.ArtifactsDir != "" {
+				fmt.Fprintf(out, "- ArtifactsDir '%v'\n", configuration.Spec.ArtifactsDir)
+			}
+			if configuration.Spec.OnFailure != nil && configuration.Spec.OnFailure.Dump != nil {
+				fmt.Fprintf(out, "- DumpOnFailure %v\n", true)
+			}
 			if len(options.selector) != 0 {
 				fmt.Fprintf(out, "- Selector %v\n", options.selector)
 			}
+			if configuration.Spec.RerunFailed != "" {
+				fmt.Fprintf(out, "- RerunFailed '%v'\n", configuration.Spec.RerunFailed)
+			}
+			if configuration.Spec.Sharding != nil {
+				fmt.Fprintf(out, "- ShardIndex %v\n", configuration.Spec.Sharding.Index)
+				fmt.Fprintf(out, "- ShardCount %v\n", configuration.Spec.Sharding.Count)
+				if configuration.Spec.Sharding.DurationsReport != "" {
+					fmt.Fprintf(out, "- ShardDurations '%v'\n", configuration.Spec.Sharding.DurationsReport)
+				}
+			}
 			// loading tests
 			fmt.Fprintln(out, "Loading tests...")
 			if err := fsutils.CheckFolders(options.testDirs...); err != nil {
@@ -202,6 +354,37 @@ func Command() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if configuration.Spec.RerunFailed != "" {
+				previous, err := report.Load(configuration.Spec.RerunFailed)
+				if err != nil {
+					return fmt.Errorf("failed to load report to rerun (%w)", err)
+				}
+				failed := previous.FailedTests()
+				var rerun []discovery.Test
+				for _, test := range tests {
+					if slices.Contains(failed, test.Name) {
+						rerun = append(rerun, test)
+					}
+				}
+				fmt.Fprintf(out, "- Selected %d failed tests out of %d tests\n", len(rerun), len(tests))
+				tests = rerun
+			}
+			if sharding := configuration.Spec.Sharding; sharding != nil {
+				var durations map[string]time.Duration
+				if sharding.DurationsReport != "" {
+					durationsReport, err := report.Load(sharding.DurationsReport)
+					if err != nil {
+						return fmt.Errorf("failed to load durations report (%w)", err)
+					}
+					durations = durationsReport.Durations()
+				}
+				discovered := len(tests)
+				tests, err = discovery.Shard(tests, sharding.Index, sharding.Count, durations)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "- Selected %d out of %d tests for shard %d\n", len(tests), discovered, sharding.Index)
+			}
 			var testToRun []discovery.Test
 			for _, test := range tests {
 				if test.Err != nil {
@@ -222,13 +405,19 @@ func Command() *cobra.Command {
 				fmt.Fprintln(out, "Tests Summary...")
 				fmt.Fprintln(out, "- Passed  tests", summary.Passed())
 				fmt.Fprintln(out, "- Failed  tests", summary.Failed())
+				fmt.Fprintln(out, "- Flaky   tests", summary.Flaky())
 				fmt.Fprintln(out, "- Skipped tests", summary.Skipped())
 			}
-			if err != nil {
+			if errors.Is(err, runner.ErrInterrupted) {
+				fmt.Fprintln(out, "Interrupted.")
+			} else if err != nil {
 				fmt.Fprintln(out, "Done with error.")
 			} else if summary != nil && summary.Failed() > 0 {
 				fmt.Fprintln(out, "Done with failures.")
 				err = errors.New("some tests failed")
+			} else if summary != nil && summary.Flaky() > 0 && configuration.Spec.FailOnFlaky {
+				fmt.Fprintln(out, "Done with flaky tests.")
+				err = errors.New("some tests are flaky")
 			} else {
 				fmt.Fprintln(out, "Done.")
 			}
@@ -242,21 +431,39 @@ func Command() *cobra.Command {
 	cmd.Flags().DurationVar(&options.deleteTimeout.Duration, "delete-timeout", v1alpha1.DefaultDeleteTimeout, "The delete timeout to use as default for configuration")
 	cmd.Flags().DurationVar(&options.cleanupTimeout.Duration, "cleanup-timeout", v1alpha1.DefaultCleanupTimeout, "The cleanup timeout to use as default for configuration")
 	cmd.Flags().DurationVar(&options.execTimeout.Duration, "exec-timeout", v1alpha1.DefaultExecTimeout, "The exec timeout to use as default for configuration")
+	cmd.Flags().DurationVar(&options.waitTimeout.Duration, "wait-timeout", v1alpha1.DefaultWaitTimeout, "The wait timeout to use as default for configuration")
+	cmd.Flags().DurationVar(&options.pollInterval.Duration, "poll-interval", v1alpha1.DefaultPollInterval, "The interval between two polling attempts (the initial interval when using a backoff)")
+	cmd.Flags().IntVar(&options.pollBackoffFactor, "poll-backoff-factor", v1alpha1.DefaultBackoffFactor, "If set, enables exponential backoff and multiplies the poll interval by this factor after every attempt")
+	cmd.Flags().DurationVar(&options.pollBackoffMaxInterval.Duration, "poll-backoff-max-interval", v1alpha1.DefaultBackoffMaxInterval, "If set, enables exponential backoff and caps the poll interval to this value")
 	cmd.Flags().StringVar(&options.config, "config", "", "Chainsaw configuration file")
 	cmd.Flags().StringArrayVar(&options.testDirs, "test-dir", []string{}, "Directories containing test cases to run")
 	cmd.Flags().BoolVar(&options.skipDelete, "skip-delete", false, "If set, do not delete the resources after running the tests")
 	cmd.Flags().BoolVar(&options.failFast, "fail-fast", false, "Stop the test upon encountering the first failure")
 	cmd.Flags().IntVar(&options.parallel, "parallel", 0, "The maximum number of tests to run at once")
 	cmd.Flags().IntVar(&options.repeatCount, "repeat-count", 1, "Number of times to repeat each test")
-	cmd.Flags().StringVar(&options.reportFormat, "report-format", "", "Test report format (JSON|XML|nil)")
+	cmd.Flags().IntVar(&options.retries, "retries", 0, "Number of times to retry a failed test")
+	cmd.Flags().BoolVar(&options.failOnFlaky, "fail-on-flaky", false, "Consider flaky tests (tests that passed after being retried) as failures")
+	cmd.Flags().StringVar(&options.shuffle, "shuffle", "off", "Randomize the execution order of tests (off, on or an integer seed), steps are shuffled only in tests allowing it")
+	cmd.Flags().StringVar(&options.reportFormat, "report-format", "", "Test report format (JSON|XML|JUNIT|HTML|MARKDOWN|nil)")
 	cmd.Flags().StringVar(&options.reportName, "report-name", "chainsaw-report", "The name of the report to create")
+	cmd.Flags().BoolVar(&options.githubStepSummary, "github-step-summary", false, "Append a Markdown summary of the results to the file named by $GITHUB_STEP_SUMMARY")
+	cmd.Flags().StringVar(&options.artifactsDir, "artifacts-dir", "", "Directory where collectors write their output, nothing is written if not set")
+	cmd.Flags().BoolVar(&options.dumpOnFailure, "dump-on-failure", false, "Dump the cluster state when a test fails")
 	cmd.Flags().StringVar(&options.namespace, "namespace", "", "Namespace to use for tests")
 	cmd.Flags().BoolVar(&options.fullName, "full-name", false, "Use full test case folder path instead of folder name")
 	cmd.Flags().StringVar(&options.includeTestRegex, "include-test-regex", "", "Regular expression to include tests")
 	cmd.Flags().StringVar(&options.excludeTestRegex, "exclude-test-regex", "", "Regular expression to exclude tests")
+	cmd.Flags().IntVar(&options.shardIndex, "shard-index", 0, "The zero based index of the shard to run")
+	cmd.Flags().IntVar(&options.shardCount, "shard-count", 0, "The number of shards to split tests into")
+	cmd.Flags().StringVar(&options.shardDurations, "shard-durations", "", "JSON report of a previous run used to balance shards based on test durations")
+	cmd.Flags().StringVar(&options.rerunFailed, "rerun-failed", "", "JSON report of a previous run, only the tests that failed in this run are executed")
 	cmd.Flags().BoolVar(&options.noColor, "no-color", false, "Removes output colors")
 	cmd.Flags().DurationVar(&options.forceTerminationGracePeriod.Duration, "force-termination-grace-period", 0, "If specified, overrides termination grace periods in applicable resources")
 	cmd.Flags().DurationVar(&options.delayBeforeCleanup.Duration, "cleanup-delay", 0, "Adds a delay between the time a test ends and the time cleanup starts")
+	cmd.Flags().BoolVar(&options.serverSideApply, "server-side-apply", false, "Use server-side apply for apply operations")
+	cmd.Flags().StringVar(&options.fieldManager, "field-manager", v1alpha1.DefaultFieldManager, "The field manager name used by server-side apply")
+	cmd.Flags().BoolVar(&options.forceConflicts, "force-conflicts", false, "Take ownership of conflicting fields when using server-side apply")
+	cmd.Flags().BoolVar(&options.template, "template", false, "Apply templating to resources before executing operations")
 	cmd.Flags().StringSliceVar(&options.selector, "selector", []string{}, "Selector (label query) to filter on")
 	clientcmd.BindOverrideFlags(&options.kubeConfigOverrides, cmd.Flags(), clientcmd.RecommendedConfigOverrideFlags("kube-"))
 	if err := cmd.MarkFlagFilename("config"); err != nil {
@@ -18,6 +18,8 @@ func TestChainsawCommand(t *testing.T) {
 		wantErr bool
 		out     string
 		err     string
+		// reports written by the command, removed once the test completes
+		reports []string
 	}{{
 		name: "help",
 		args: []string{
@@ -119,6 +121,7 @@ func TestChainsawCommand(t *testing.T) {
 		},
 		wantErr: false,
 		out:     filepath.Join(basePath, "config_all_fields.txt"),
+		reports: []string{"custom-chainsaw-report.json"},
 	}, {
 		name: "all flags",
 		args: []string{
@@ -129,24 +132,79 @@ func TestChainsawCommand(t *testing.T) {
 			"--delete-timeout=100s",
 			"--cleanup-timeout=100s",
 			"--exec-timeout=100s",
+			"--wait-timeout=100s",
+			"--poll-interval=1s",
+			"--poll-backoff-factor=3",
+			"--poll-backoff-max-interval=10s",
 			"--test-dir=.",
 			"--skip-delete=false",
 			"--fail-fast=false",
 			"--parallel=24",
 			"--repeat-count=12",
+			"--retries=2",
+			"--fail-on-flaky=true",
+			"--shuffle=42",
 			"--report-format=XML",
 			"--report-name=foo",
+			"--github-step-summary=true",
+			"--artifacts-dir=artifacts",
+			"--dump-on-failure=true",
 			"--namespace=bar",
 			"--full-name=true",
 			"--include-test-regex=^.*$",
 			"--exclude-test-regex=^.*$",
 			"--force-termination-grace-period=5s",
+			"--server-side-apply=true",
+			"--field-manager=foo",
+			"--force-conflicts=true",
+			"--template=true",
+			"--shard-index=1",
+			"--shard-count=3",
+			"--shard-durations=../../../testdata/commands/report/merge/shard-1.json",
+			"--rerun-failed=../../../testdata/commands/report/merge/shard-1.json",
 		},
 		wantErr: false,
 		out:     filepath.Join(basePath, "all_flags.txt"),
+		reports: []string{"foo.xml"},
+	}, {
+		name: "invalid shard index",
+		args: []string{
+			"--shard-index=2",
+			"--shard-count=2",
+		},
+		wantErr: true,
+	}, {
+		name: "shuffle with random seed",
+		args: []string{
+			"--shuffle=on",
+		},
+		wantErr: false,
+	}, {
+		name: "invalid shuffle",
+		args: []string{
+			"--shuffle=foo",
+		},
+		wantErr: true,
+	}, {
+		name: "missing rerun report",
+		args: []string{
+			"--rerun-failed=nonexistent.json",
+		},
+		wantErr: true,
+	}, {
+		name: "missing shard durations report",
+		args: []string{
+			"--shard-count=2",
+			"--shard-durations=nonexistent.json",
+		},
+		wantErr: true,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			for _, report := range tt.reports {
+				report := report
+				t.Cleanup(func() { _ = os.Remove(report) })
+			}
 			cmd := Command()
 			assert.NotNil(t, cmd)
 			cmd.SetArgs(tt.args)
@@ -56,6 +56,7 @@ func TestLoad(t *testing.T) {
 				FullName:         false,
 				IncludeTestRegex: "",
 				ExcludeTestRegex: "",
+				FieldManager:     "chainsaw",
 			},
 		},
 	}, {
@@ -88,6 +89,7 @@ func TestLoad(t *testing.T) {
 				IncludeTestRegex:            "include-*",
 				ExcludeTestRegex:            "exclude-*",
 				ForceTerminationGracePeriod: &metav1.Duration{Duration: 10 * time.Second},
+				FieldManager:                "chainsaw",
 			},
 		},
 	}, {
@@ -0,0 +1,62 @@
+package discovery
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Shard returns the tests belonging to the shard at the given index when splitting tests into count shards.
+// Tests are distributed round robin unless durations are provided, in which case every test is assigned to the
+// least loaded shard, longest tests first. Tests without a known duration are assumed to take the average duration.
+// The order of tests is preserved in the returned shard.
+func Shard(tests []Test, index int, count int, durations map[string]time.Duration) ([]Test, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("invalid shard count %d, must be at least 1", count)
+	}
+	if index < 0 || index >= count {
+		return nil, fmt.Errorf("invalid shard index %d, must be between 0 and %d", index, count-1)
+	}
+	shards := make([]int, len(tests))
+	if len(durations) == 0 {
+		for i := range tests {
+			shards[i] = i % count
+		}
+	} else {
+		var total time.Duration
+		for _, duration := range durations {
+			total += duration
+		}
+		average := total / time.Duration(len(durations))
+		estimates := make([]time.Duration, len(tests))
+		order := make([]int, len(tests))
+		for i, test := range tests {
+			estimates[i] = average
+			if duration, ok := durations[test.Name]; ok {
+				estimates[i] = duration
+			}
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			return estimates[order[i]] > estimates[order[j]]
+		})
+		loads := make([]time.Duration, count)
+		for _, i := range order {
+			shard := 0
+			for s := range loads {
+				if loads[s] < loads[shard] {
+					shard = s
+				}
+			}
+			shards[i] = shard
+			loads[shard] += estimates[i]
+		}
+	}
+	var out []Test
+	for i, test := range tests {
+		if shards[i] == index {
+			out = append(out, test)
+		}
+	}
+	return out, nil
+}
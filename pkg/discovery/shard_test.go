@@ -0,0 +1,153 @@
+package discovery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func shardTests(names ...string) []Test {
+	var tests []Test
+	for _, name := range names {
+		tests = append(tests, Test{
+			Test: &v1alpha1.Test{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+			},
+		})
+	}
+	return tests
+}
+
+func shardNames(tests []Test) []string {
+	var names []string
+	for _, test := range tests {
+		names = append(names, test.Name)
+	}
+	return names
+}
+
+func TestShard(t *testing.T) {
+	tests := []struct {
+		name      string
+		tests     []Test
+		index     int
+		count     int
+		durations map[string]time.Duration
+		want      []string
+		wantErr   bool
+	}{{
+		name:    "invalid count",
+		tests:   shardTests("a", "b"),
+		index:   0,
+		count:   0,
+		wantErr: true,
+	}, {
+		name:    "negative index",
+		tests:   shardTests("a", "b"),
+		index:   -1,
+		count:   2,
+		wantErr: true,
+	}, {
+		name:    "index out of range",
+		tests:   shardTests("a", "b"),
+		index:   2,
+		count:   2,
+		wantErr: true,
+	}, {
+		name:  "single shard",
+		tests: shardTests("a", "b", "c"),
+		index: 0,
+		count: 1,
+		want:  []string{"a", "b", "c"},
+	}, {
+		name:  "round robin first shard",
+		tests: shardTests("a", "b", "c", "d", "e"),
+		index: 0,
+		count: 2,
+		want:  []string{"a", "c", "e"},
+	}, {
+		name:  "round robin second shard",
+		tests: shardTests("a", "b", "c", "d", "e"),
+		index: 1,
+		count: 2,
+		want:  []string{"b", "d"},
+	}, {
+		name:  "more shards than tests",
+		tests: shardTests("a"),
+		index: 1,
+		count: 2,
+	}, {
+		name:  "durations first shard",
+		tests: shardTests("a", "b", "c", "d"),
+		index: 0,
+		count: 2,
+		durations: map[string]time.Duration{
+			"a": 10 * time.Second,
+			"b": 1 * time.Second,
+			"c": 2 * time.Second,
+			"d": 3 * time.Second,
+		},
+		want: []string{"a"},
+	}, {
+		name:  "durations second shard",
+		tests: shardTests("a", "b", "c", "d"),
+		index: 1,
+		count: 2,
+		durations: map[string]time.Duration{
+			"a": 10 * time.Second,
+			"b": 1 * time.Second,
+			"c": 2 * time.Second,
+			"d": 3 * time.Second,
+		},
+		want: []string{"b", "c", "d"},
+	}, {
+		name:  "unknown durations use average",
+		tests: shardTests("a", "b", "c", "d"),
+		index: 1,
+		count: 2,
+		durations: map[string]time.Duration{
+			"a": 4 * time.Second,
+			"b": 2 * time.Second,
+		},
+		want: []string{"c", "d"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Shard(tt.tests, tt.index, tt.count, tt.durations)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, shardNames(got))
+			}
+		})
+	}
+}
+
+func TestShard_Coverage(t *testing.T) {
+	tests := shardTests("a", "b", "c", "d", "e", "f", "g")
+	durations := map[string]time.Duration{
+		"a": 5 * time.Second,
+		"c": 1 * time.Second,
+		"f": 8 * time.Second,
+	}
+	for _, durations := range []map[string]time.Duration{nil, durations} {
+		seen := map[string]int{}
+		for index := 0; index < 3; index++ {
+			shard, err := Shard(tests, index, 3, durations)
+			assert.NoError(t, err)
+			for _, name := range shardNames(shard) {
+				seen[name]++
+			}
+		}
+		assert.Len(t, seen, len(tests))
+		for name, count := range seen {
+			assert.Equal(t, 1, count, name)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package report
+
+import (
+	"bytes"
+	_ "embed"
+	"html/template"
+	"strings"
+)
+
+//go:embed html.tmpl
+var htmlTemplate string
+
+var htmlFuncs = template.FuncMap{
+	"testStatus":      testStatus,
+	"operationStatus": operationStatus,
+	"sectionTitle":    sectionTitle,
+	"sectionBody":     sectionBody,
+	"isErrorSection":  isErrorSection,
+	"stepFailed": func(step *TestSpecStepReport) bool {
+		for _, op := range step.Operations() {
+			if op.Result == "Failure" {
+				return true
+			}
+		}
+		return false
+	},
+	"hasErrorSection": func(sections []string) bool {
+		for _, section := range sections {
+			if isErrorSection(section) {
+				return true
+			}
+		}
+		return false
+	},
+	"count": func(report *TestsReport, status string) int {
+		count := 0
+		for _, test := range report.Reports {
+			if testStatus(test) == status {
+				count++
+			}
+		}
+		return count
+	},
+}
+
+// HTMLSerializer renders reports into a single self-contained HTML page.
+type HTMLSerializer struct{}
+
+func (s HTMLSerializer) Serialize(report *TestsReport) ([]byte, error) {
+	tmpl, err := template.New("report").Funcs(htmlFuncs).Parse(htmlTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, report); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func testStatus(test *TestReport) string {
+	switch {
+	case test.Skip:
+		return "skipped"
+	case test.Failure != nil:
+		return "failed"
+	case test.Flaky:
+		return "flaky"
+	default:
+		return "passed"
+	}
+}
+
+func operationStatus(op *OperationReport) string {
+	switch op.Result {
+	case "Success":
+		return "passed"
+	case "Failure":
+		return "failed"
+	default:
+		return "skipped"
+	}
+}
+
+// sectionTitle returns the name of a section produced by logging.Section, sections look like "=== NAME\ncontent".
+func sectionTitle(section string) string {
+	title, _, _ := strings.Cut(section, "\n")
+	if !strings.HasPrefix(title, "=== ") {
+		return ""
+	}
+	return strings.TrimPrefix(title, "=== ")
+}
+
+func sectionBody(section string) string {
+	if sectionTitle(section) == "" {
+		return section
+	}
+	_, body, _ := strings.Cut(section, "\n")
+	return body
+}
+
+func isErrorSection(section string) bool {
+	return sectionTitle(section) == "ERROR"
+}
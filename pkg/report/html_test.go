@@ -0,0 +1,135 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTMLSerializer_Serialize(t *testing.T) {
+	timeStamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	report := &TestsReport{
+		Name:      "chainsaw",
+		TimeStamp: timeStamp,
+		Time:      "3.000",
+		Reports: []*TestReport{{
+			Name:      "passing",
+			TimeStamp: timeStamp,
+			Time:      "1.000",
+			Namespace: "chainsaw-foo",
+			Steps: []*TestSpecStepReport{{
+				Name: "step-1",
+				Results: []*OperationReport{{
+					Name:          "Command",
+					Time:          "0.500",
+					Result:        "Success",
+					OperationType: OperationTypeCommand,
+					Output:        []string{"=== COMMAND\necho <foo>", "=== STDOUT\n<foo>"},
+				}},
+				Finally: []*OperationReport{{
+					Name:          "Sleep",
+					Time:          "0.100",
+					Result:        "Success",
+					OperationType: OperationTypeSleep,
+				}},
+			}},
+		}, {
+			Name:      "failing",
+			TimeStamp: timeStamp,
+			Time:      "2.000",
+			Failure:   &Failure{Message: "test failed", Errors: []string{"Assert: spec.replicas: Invalid value"}},
+			Steps: []*TestSpecStepReport{{
+				Name: "step-1",
+				Results: []*OperationReport{{
+					Name:          "Assert Deployment/foo",
+					Time:          "1.000",
+					Result:        "Failure",
+					Message:       "spec.replicas: Invalid value",
+					OperationType: OperationTypeAssert,
+					Output:        []string{"=== ERROR\nspec.replicas: Invalid value"},
+				}, {
+					Name:          "Script",
+					Time:          "1.000",
+					Result:        "Failure",
+					Message:       "exit status 1",
+					OperationType: OperationTypeScript,
+				}},
+			}},
+		}, {
+			Name:       "skipped",
+			TimeStamp:  timeStamp,
+			Skip:       true,
+			SkipReason: "test is marked as skipped",
+		}},
+	}
+	data, err := HTMLSerializer{}.Serialize(report)
+	assert.NoError(t, err)
+	html := string(data)
+	assert.Contains(t, html, `<span class="status passed">Passed: 1</span>`)
+	assert.Contains(t, html, `<span class="status failed">Failed: 1</span>`)
+	assert.Contains(t, html, `<span class="status skipped">Skipped: 1</span>`)
+	assert.Contains(t, html, `<details class="test passed">`)
+	assert.Contains(t, html, `<details class="test failed" open>`)
+	assert.Contains(t, html, `<details class="test skipped">`)
+	assert.Contains(t, html, `<details class="step" open>`)
+	// output is escaped
+	assert.Contains(t, html, "<pre>echo &lt;foo&gt;</pre>")
+	assert.Contains(t, html, `<div class="section">FINALLY</div>`)
+	assert.Contains(t, html, "<pre class=\"error\">test failed\nAssert: spec.replicas: Invalid value</pre>")
+	assert.Contains(t, html, `<div class="section error">ERROR</div>`)
+	assert.Contains(t, html, `<pre class="error">spec.replicas: Invalid value</pre>`)
+	// failed operations without error section show their message
+	assert.Contains(t, html, `<pre class="error">exit status 1</pre>`)
+	assert.Contains(t, html, `<div class="meta">Skipped: test is marked as skipped</div>`)
+	assert.Contains(t, html, `<span class="status flaky">Flaky: 0</span>`)
+	assert.NotContains(t, html, "Rerun of the failed tests")
+	report.RerunOf = "previous.json"
+	data, err = HTMLSerializer{}.Serialize(report)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `<div class="meta">Rerun of the failed tests from previous.json</div>`)
+}
+
+func Test_sectionTitle(t *testing.T) {
+	tests := []struct {
+		section string
+		title   string
+		body    string
+	}{{
+		section: "=== STDOUT\nfoo\nbar",
+		title:   "STDOUT",
+		body:    "foo\nbar",
+	}, {
+		section: "=== ERROR",
+		title:   "ERROR",
+		body:    "",
+	}, {
+		section: "not a section",
+		title:   "",
+		body:    "not a section",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.section, func(t *testing.T) {
+			assert.Equal(t, tt.title, sectionTitle(tt.section))
+			assert.Equal(t, tt.body, sectionBody(tt.section))
+		})
+	}
+}
+
+func TestHTMLSerializer_SerializeFlaky(t *testing.T) {
+	report := &TestsReport{
+		Name: "chainsaw",
+		Reports: []*TestReport{{
+			Name:     "retried",
+			Time:     "1.000",
+			Attempts: 3,
+			Flaky:    true,
+		}},
+	}
+	data, err := HTMLSerializer{}.Serialize(report)
+	assert.NoError(t, err)
+	html := string(data)
+	assert.Contains(t, html, `<span class="status flaky">Flaky: 1</span>`)
+	assert.Contains(t, html, `<details class="test flaky">`)
+	assert.Contains(t, html, `<div class="meta">Attempts: 3, passed after being retried</div>`)
+}
@@ -0,0 +1,217 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JUnitTestSuites is the root element of a JUnit report.
+type JUnitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Skipped    int              `xml:"skipped,attr"`
+	Time       string           `xml:"time,attr"`
+	TimeStamp  string           `xml:"timestamp,attr"`
+	TestSuites []JUnitTestSuite `xml:"testsuite"`
+}
+
+// JUnitTestSuite corresponds to a chainsaw test.
+type JUnitTestSuite struct {
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Skipped    int              `xml:"skipped,attr"`
+	Time       string           `xml:"time,attr"`
+	TimeStamp  string           `xml:"timestamp,attr"`
+	Properties *JUnitProperties `xml:"properties,omitempty"`
+	TestCases  []JUnitTestCase  `xml:"testcase"`
+}
+
+// JUnitProperties contains the properties of a test suite.
+type JUnitProperties struct {
+	Properties []JUnitProperty `xml:"property"`
+}
+
+// JUnitProperty is a name/value pair attached to a test suite.
+type JUnitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// JUnitTestCase corresponds to a chainsaw test step.
+type JUnitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *JUnitFailure `xml:"failure,omitempty"`
+	Skipped   *JUnitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+	SystemErr string        `xml:"system-err,omitempty"`
+}
+
+// JUnitFailure describes a failed test case.
+type JUnitFailure struct {
+	Message  string `xml:"message,attr"`
+	Type     string `xml:"type,attr"`
+	Contents string `xml:",chardata"`
+}
+
+// JUnitSkipped describes a skipped test case.
+type JUnitSkipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+// JUnitSerializer serializes reports following the JUnit XML schema,
+// every test becomes a test suite and every test step becomes a test case.
+type JUnitSerializer struct{}
+
+func (s JUnitSerializer) Serialize(report *TestsReport) ([]byte, error) {
+	data, err := xml.MarshalIndent(NewJUnit(report), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// NewJUnit converts a TestsReport into a JUnit report.
+func NewJUnit(report *TestsReport) JUnitTestSuites {
+	suites := JUnitTestSuites{
+		Name:      report.Name,
+		Time:      report.Time,
+		TimeStamp: junitTimeStamp(report.TimeStamp),
+	}
+	for _, test := range report.Reports {
+		suite := newJUnitTestSuite(test)
+		if report.RerunOf != "" {
+			if suite.Properties == nil {
+				suite.Properties = &JUnitProperties{}
+			}
+			suite.Properties.Properties = append(suite.Properties.Properties, JUnitProperty{Name: "rerunOf", Value: report.RerunOf})
+		}
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Skipped += suite.Skipped
+		suites.TestSuites = append(suites.TestSuites, suite)
+	}
+	return suites
+}
+
+func newJUnitTestSuite(test *TestReport) JUnitTestSuite {
+	suite := JUnitTestSuite{
+		Name:      test.Name,
+		Time:      test.Time,
+		TimeStamp: junitTimeStamp(test.TimeStamp),
+	}
+	var properties []JUnitProperty
+	if test.Namespace != "" {
+		properties = append(properties, JUnitProperty{Name: "namespace", Value: test.Namespace})
+	}
+	if test.Concurrent {
+		properties = append(properties, JUnitProperty{Name: "concurrent", Value: "true"})
+	}
+	if test.SkipDelete {
+		properties = append(properties, JUnitProperty{Name: "skipDelete", Value: "true"})
+	}
+	if test.Artifacts != "" {
+		properties = append(properties, JUnitProperty{Name: "artifacts", Value: test.Artifacts})
+	}
+	if test.Attempts != 0 {
+		properties = append(properties, JUnitProperty{Name: "attempts", Value: strconv.Itoa(test.Attempts)})
+	}
+	if test.Flaky {
+		properties = append(properties, JUnitProperty{Name: "flaky", Value: "true"})
+	}
+	if len(properties) != 0 {
+		suite.Properties = &JUnitProperties{Properties: properties}
+	}
+	for _, step := range test.Steps {
+		suite.TestCases = append(suite.TestCases, newJUnitTestCase(test.Name, step))
+	}
+	switch {
+	// a skipped test has no step, report it as a single skipped test case
+	case test.Skip:
+		suite.TestCases = []JUnitTestCase{{
+			Name:      test.Name,
+			ClassName: test.Name,
+			Time:      test.Time,
+			Skipped:   &JUnitSkipped{Message: test.SkipReason},
+		}}
+	// a test can fail outside of its steps (namespace creation for example)
+	case test.Failure != nil && !hasFailedTestCase(suite.TestCases):
+		suite.TestCases = append(suite.TestCases, JUnitTestCase{
+			Name:      test.Name,
+			ClassName: test.Name,
+			Time:      test.Time,
+			Failure: &JUnitFailure{
+				Message: test.Failure.Message,
+				Type:    "failure",
+			},
+		})
+	}
+	for _, testCase := range suite.TestCases {
+		suite.Tests++
+		if testCase.Failure != nil {
+			suite.Failures++
+		}
+		if testCase.Skipped != nil {
+			suite.Skipped++
+		}
+	}
+	return suite
+}
+
+func newJUnitTestCase(className string, step *TestSpecStepReport) JUnitTestCase {
+	testCase := JUnitTestCase{
+		Name:      step.Name,
+		ClassName: className,
+	}
+	var duration float64
+	var out, errs []string
+	for _, op := range step.Operations() {
+		if seconds, err := strconv.ParseFloat(op.Time, 64); err == nil {
+			duration += seconds
+		}
+		out = append(out, fmt.Sprintf("%s (%s): %s [%ss]", op.Name, op.OperationType, op.Result, op.Time))
+		for _, artifact := range op.Artifacts {
+			out = append(out, fmt.Sprintf("  artifact: %s", artifact))
+		}
+		if op.Result == "Failure" {
+			errs = append(errs, fmt.Sprintf("%s (%s): %s", op.Name, op.OperationType, op.Message))
+			if testCase.Failure == nil {
+				testCase.Failure = &JUnitFailure{
+					Message: op.Message,
+					Type:    string(op.OperationType),
+				}
+			}
+		}
+	}
+	testCase.Time = fmt.Sprintf("%.3f", duration)
+	testCase.SystemOut = strings.Join(out, "\n")
+	testCase.SystemErr = strings.Join(errs, "\n")
+	if testCase.Failure != nil {
+		testCase.Failure.Contents = testCase.SystemErr
+	}
+	return testCase
+}
+
+func hasFailedTestCase(testCases []JUnitTestCase) bool {
+	for _, testCase := range testCases {
+		if testCase.Failure != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// junitTimeStamp formats a time the way the JUnit schema expects it (ISO 8601 without timezone).
+func junitTimeStamp(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02T15:04:05")
+}
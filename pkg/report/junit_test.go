@@ -0,0 +1,134 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJUnitSerializer_Serialize(t *testing.T) {
+	timeStamp := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	report := &TestsReport{
+		Name:      "chainsaw",
+		TimeStamp: timeStamp,
+		Time:      "3.000",
+		Reports: []*TestReport{{
+			Name:      "passing",
+			TimeStamp: timeStamp,
+			Time:      "1.000",
+			Namespace: "chainsaw-foo",
+			Steps: []*TestSpecStepReport{{
+				Name: "step-1",
+				Results: []*OperationReport{{
+					Name:          "apply",
+					Time:          "0.250",
+					Result:        "Success",
+					OperationType: OperationTypeApply,
+				}, {
+					Name:          "command",
+					Time:          "0.500",
+					Result:        "Success",
+					OperationType: OperationTypeCommand,
+					Artifacts:     []string{"artifacts/passing/01-step-1/01-command-stdout.log"},
+				}},
+			}},
+		}, {
+			Name:      "failing",
+			TimeStamp: timeStamp,
+			Time:      "2.000",
+			Failure:   &Failure{Message: "failed"},
+			Steps: []*TestSpecStepReport{{
+				Name: "step-1",
+				Results: []*OperationReport{{
+					Name:          "assert",
+					Time:          "1.000",
+					Result:        "Failure",
+					Message:       "spec.replicas: Invalid value: 1: Expected value: 2",
+					OperationType: OperationTypeAssert,
+				}},
+			}},
+		}, {
+			Name:      "broken",
+			TimeStamp: timeStamp,
+			Time:      "0.000",
+			Failure:   &Failure{Message: "failed to create namespace"},
+		}, {
+			Name:      "skipped",
+			TimeStamp: timeStamp,
+			Time:      "0.000",
+			Skip:      true,
+		}},
+	}
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="chainsaw" tests="4" failures="2" skipped="1" time="3.000" timestamp="2024-01-01T12:00:00">
+  <testsuite name="passing" tests="1" failures="0" skipped="0" time="1.000" timestamp="2024-01-01T12:00:00">
+    <properties>
+      <property name="namespace" value="chainsaw-foo"></property>
+    </properties>
+    <testcase name="step-1" classname="passing" time="0.750">
+      <system-out>apply (apply): Success [0.250s]&#xA;command (command): Success [0.500s]&#xA;  artifact: artifacts/passing/01-step-1/01-command-stdout.log</system-out>
+    </testcase>
+  </testsuite>
+  <testsuite name="failing" tests="1" failures="1" skipped="0" time="2.000" timestamp="2024-01-01T12:00:00">
+    <testcase name="step-1" classname="failing" time="1.000">
+      <failure message="spec.replicas: Invalid value: 1: Expected value: 2" type="assert">assert (assert): spec.replicas: Invalid value: 1: Expected value: 2</failure>
+      <system-out>assert (assert): Failure [1.000s]</system-out>
+      <system-err>assert (assert): spec.replicas: Invalid value: 1: Expected value: 2</system-err>
+    </testcase>
+  </testsuite>
+  <testsuite name="broken" tests="1" failures="1" skipped="0" time="0.000" timestamp="2024-01-01T12:00:00">
+    <testcase name="broken" classname="broken" time="0.000">
+      <failure message="failed to create namespace" type="failure"></failure>
+    </testcase>
+  </testsuite>
+  <testsuite name="skipped" tests="1" failures="0" skipped="1" time="0.000" timestamp="2024-01-01T12:00:00">
+    <testcase name="skipped" classname="skipped" time="0.000">
+      <skipped></skipped>
+    </testcase>
+  </testsuite>
+</testsuites>`
+	data, err := JUnitSerializer{}.Serialize(report)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(data))
+}
+
+func TestNewJUnit_Rerun(t *testing.T) {
+	report := &TestsReport{
+		Name:    "chainsaw",
+		RerunOf: "previous.json",
+		Reports: []*TestReport{{
+			Name:      "test",
+			Namespace: "chainsaw-foo",
+		}, {
+			Name: "other",
+		}},
+	}
+	junit := NewJUnit(report)
+	assert.Len(t, junit.TestSuites, 2)
+	assert.Equal(t, []JUnitProperty{
+		{Name: "namespace", Value: "chainsaw-foo"},
+		{Name: "rerunOf", Value: "previous.json"},
+	}, junit.TestSuites[0].Properties.Properties)
+	assert.Equal(t, []JUnitProperty{
+		{Name: "rerunOf", Value: "previous.json"},
+	}, junit.TestSuites[1].Properties.Properties)
+}
+
+func TestNewJUnit_Flaky(t *testing.T) {
+	report := &TestsReport{
+		Name: "chainsaw",
+		Reports: []*TestReport{{
+			Name:     "retried",
+			Attempts: 2,
+			Flaky:    true,
+		}},
+	}
+	junit := NewJUnit(report)
+	assert.Len(t, junit.TestSuites, 1)
+	assert.Equal(t, []JUnitProperty{
+		{Name: "attempts", Value: "2"},
+		{Name: "flaky", Value: "true"},
+	}, junit.TestSuites[0].Properties.Properties)
+	assert.Equal(t, 0, junit.Failures)
+}
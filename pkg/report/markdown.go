@@ -0,0 +1,122 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MarkdownSerializer renders a summary of reports in Markdown, suitable for CI job summaries and PR comments.
+type MarkdownSerializer struct{}
+
+func (s MarkdownSerializer) Serialize(report *TestsReport) ([]byte, error) {
+	var buffer bytes.Buffer
+	counts := map[string]int{}
+	var failed []*TestReport
+	for _, test := range report.Reports {
+		status := testStatus(test)
+		counts[status]++
+		if status == "failed" {
+			failed = append(failed, test)
+		}
+	}
+	fmt.Fprintf(&buffer, "## %s\n\n", markdownText(report.Name))
+	if report.RerunOf != "" {
+		fmt.Fprintf(&buffer, "Rerun of the failed tests from %s\n\n", markdownCode(report.RerunOf))
+	}
+	fmt.Fprintln(&buffer, "| Result | Count |")
+	fmt.Fprintln(&buffer, "|---|---|")
+	fmt.Fprintf(&buffer, "| ✅ Passed | %d |\n", counts["passed"])
+	fmt.Fprintf(&buffer, "| ❌ Failed | %d |\n", counts["failed"])
+	fmt.Fprintf(&buffer, "| ⚠️ Flaky | %d |\n", counts["flaky"])
+	fmt.Fprintf(&buffer, "| ⏭️ Skipped | %d |\n", counts["skipped"])
+	if report.Time != "" {
+		fmt.Fprintf(&buffer, "\nTotal duration: %ss\n", report.Time)
+	}
+	if len(failed) != 0 {
+		fmt.Fprintln(&buffer, "\n### Failed tests")
+		fmt.Fprintln(&buffer)
+		fmt.Fprintln(&buffer, "| Test | Error |")
+		fmt.Fprintln(&buffer, "|---|---|")
+		for _, test := range failed {
+			fmt.Fprintf(&buffer, "| %s | %s |\n", markdownText(test.Name), markdownCode(firstError(test)))
+		}
+	}
+	if len(report.Reports) != 0 {
+		// slowest tests first
+		tests := append([]*TestReport(nil), report.Reports...)
+		sort.SliceStable(tests, func(i, j int) bool {
+			return seconds(tests[i].Time) > seconds(tests[j].Time)
+		})
+		fmt.Fprintln(&buffer, "\n### Durations")
+		fmt.Fprintln(&buffer)
+		fmt.Fprintln(&buffer, "| Test | Result | Duration |")
+		fmt.Fprintln(&buffer, "|---|---|---|")
+		for _, test := range tests {
+			duration := ""
+			if test.Time != "" {
+				duration = test.Time + "s"
+			}
+			fmt.Fprintf(&buffer, "| %s | %s | %s |\n", markdownText(test.Name), testStatus(test), duration)
+		}
+	}
+	return buffer.Bytes(), nil
+}
+
+// AppendStepSummary appends the Markdown summary of the report to the file named by $GITHUB_STEP_SUMMARY,
+// it does nothing when the variable is not set.
+func AppendStepSummary(report *TestsReport) error {
+	path := os.Getenv("GITHUB_STEP_SUMMARY")
+	if path == "" {
+		return nil
+	}
+	data, err := MarkdownSerializer{}.Serialize(report)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func firstError(test *TestReport) string {
+	if test.Failure == nil {
+		return ""
+	}
+	if len(test.Failure.Errors) != 0 {
+		return test.Failure.Errors[0]
+	}
+	return test.Failure.Message
+}
+
+func seconds(duration string) float64 {
+	value, err := strconv.ParseFloat(duration, 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// markdownText escapes text to be used in a table cell.
+func markdownText(text string) string {
+	text = strings.ReplaceAll(text, "|", "\\|")
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// markdownCode formats text as inline code to be used in a table cell.
+func markdownCode(text string) string {
+	text = markdownText(text)
+	if text == "" {
+		return ""
+	}
+	return "`" + strings.ReplaceAll(text, "`", "'") + "`"
+}
@@ -0,0 +1,103 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkdownSerializer_Serialize(t *testing.T) {
+	report := &TestsReport{
+		Name: "chainsaw",
+		Time: "6.000",
+		Reports: []*TestReport{{
+			Name: "fast",
+			Time: "1.000",
+		}, {
+			Name:    "failing",
+			Time:    "3.000",
+			Failure: &Failure{Message: "test failed", Errors: []string{"Assert Deployment/foo: spec.replicas | status: invalid", "Script: exit status 1"}},
+		}, {
+			Name:    "broken",
+			Time:    "2.000",
+			Failure: &Failure{Message: "test failed"},
+		}, {
+			Name:     "retried",
+			Time:     "0.500",
+			Attempts: 2,
+			Flaky:    true,
+		}, {
+			Name:       "skipped",
+			Skip:       true,
+			SkipReason: "test is marked as skipped",
+		}},
+	}
+	expected := "## chainsaw\n" +
+		"\n" +
+		"| Result | Count |\n" +
+		"|---|---|\n" +
+		"| ✅ Passed | 1 |\n" +
+		"| ❌ Failed | 2 |\n" +
+		"| ⚠️ Flaky | 1 |\n" +
+		"| ⏭️ Skipped | 1 |\n" +
+		"\n" +
+		"Total duration: 6.000s\n" +
+		"\n" +
+		"### Failed tests\n" +
+		"\n" +
+		"| Test | Error |\n" +
+		"|---|---|\n" +
+		"| failing | `Assert Deployment/foo: spec.replicas \\| status: invalid` |\n" +
+		"| broken | `test failed` |\n" +
+		"\n" +
+		"### Durations\n" +
+		"\n" +
+		"| Test | Result | Duration |\n" +
+		"|---|---|---|\n" +
+		"| failing | failed | 3.000s |\n" +
+		"| broken | failed | 2.000s |\n" +
+		"| fast | passed | 1.000s |\n" +
+		"| retried | flaky | 0.500s |\n" +
+		"| skipped | skipped |  |\n"
+	data, err := MarkdownSerializer{}.Serialize(report)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(data))
+}
+
+func TestMarkdownSerializer_SerializeRerun(t *testing.T) {
+	report := &TestsReport{
+		Name:    "chainsaw",
+		RerunOf: "previous.json",
+	}
+	expected := "## chainsaw\n" +
+		"\n" +
+		"Rerun of the failed tests from `previous.json`\n" +
+		"\n" +
+		"| Result | Count |\n" +
+		"|---|---|\n" +
+		"| ✅ Passed | 0 |\n" +
+		"| ❌ Failed | 0 |\n" +
+		"| ⚠️ Flaky | 0 |\n" +
+		"| ⏭️ Skipped | 0 |\n"
+	data, err := MarkdownSerializer{}.Serialize(report)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(data))
+}
+
+func TestAppendStepSummary(t *testing.T) {
+	report := &TestsReport{Name: "chainsaw", Reports: []*TestReport{{Name: "test", Time: "1.000"}}}
+	// nothing happens without the environment variable
+	t.Setenv("GITHUB_STEP_SUMMARY", "")
+	assert.NoError(t, AppendStepSummary(report))
+	path := filepath.Join(t.TempDir(), "summary.md")
+	assert.NoError(t, os.WriteFile(path, []byte("existing\n"), 0o600))
+	t.Setenv("GITHUB_STEP_SUMMARY", path)
+	assert.NoError(t, AppendStepSummary(report))
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	expected, err := MarkdownSerializer{}.Serialize(report)
+	assert.NoError(t, err)
+	assert.Equal(t, "existing\n"+string(expected)+"\n", string(content))
+}
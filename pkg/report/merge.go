@@ -0,0 +1,102 @@
+package report
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+	"time"
+)
+
+// Load reads a report saved in JSON format.
+func Load(path string) (*TestsReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var report TestsReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// Merge combines multiple reports into a single one with the given name.
+// The merged report starts with the earliest report and ends with the latest one, totals are recomputed.
+// Every test entry is kept, except when a later report is a rerun: failed tests are rerun by name,
+// the entries of a rerun report replace the entries with the same name from the reports produced before it.
+func Merge(name string, reports ...*TestsReport) *TestsReport {
+	merged := &TestsReport{
+		Name:    name,
+		Reports: []*TestReport{},
+	}
+	var end time.Time
+	reruns := map[*TestsReport]map[string]bool{}
+	for _, report := range reports {
+		if report == nil {
+			continue
+		}
+		if merged.TimeStamp.IsZero() || report.TimeStamp.Before(merged.TimeStamp) {
+			merged.TimeStamp = report.TimeStamp
+		}
+		reportEnd := report.TimeStamp.Add(time.Duration(seconds(report.Time) * float64(time.Second)))
+		if reportEnd.After(end) {
+			end = reportEnd
+		}
+		if report.RerunOf != "" {
+			reruns[report] = map[string]bool{}
+			for _, test := range report.Reports {
+				reruns[report][test.Name] = true
+			}
+		}
+	}
+	for _, report := range reports {
+		if report == nil {
+			continue
+		}
+		for _, test := range report.Reports {
+			if !rerun(reruns, report, test.Name) {
+				merged.Reports = append(merged.Reports, test)
+			}
+		}
+	}
+	merged.Time = calculateDuration(merged.TimeStamp, end)
+	merged.computeTotals()
+	return merged
+}
+
+// rerun returns true if a test of the given report was rerun by a report produced after it.
+func rerun(reruns map[*TestsReport]map[string]bool, report *TestsReport, name string) bool {
+	for rerun, tests := range reruns {
+		if rerun != report && report.TimeStamp.Before(rerun.TimeStamp) && tests[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// Durations returns the duration of every test in the report, indexed by test name.
+// Skipped tests are ignored and the longest duration is kept when a test was run multiple times.
+func (tr *TestsReport) Durations() map[string]time.Duration {
+	durations := map[string]time.Duration{}
+	for _, test := range tr.Reports {
+		if test.Skip {
+			continue
+		}
+		duration := time.Duration(seconds(test.Time) * float64(time.Second))
+		if duration > durations[test.Name] {
+			durations[test.Name] = duration
+		}
+	}
+	return durations
+}
+
+// FailedTests returns the names of the failed tests in the report.
+func (tr *TestsReport) FailedTests() []string {
+	var failed []string
+	for _, test := range tr.Reports {
+		if test.Failure != nil && !slices.Contains(failed, test.Name) {
+			failed = append(failed, test.Name)
+		}
+	}
+	return failed
+}
@@ -0,0 +1,142 @@
+package report
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	report := &TestsReport{
+		Name:      "shard",
+		TimeStamp: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		Time:      "1.000",
+		Reports: []*TestReport{{
+			Name:    "test-1",
+			Test:    1,
+			Failure: &Failure{Message: "failed"},
+		}},
+	}
+	data, err := JSONSerializer{}.Serialize(report)
+	assert.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "report.json")
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+	loaded, err := Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "shard", loaded.Name)
+	assert.True(t, report.TimeStamp.Equal(loaded.TimeStamp))
+	assert.Len(t, loaded.Reports, 1)
+	assert.NotNil(t, loaded.Reports[0].Failure)
+	_, err = Load(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+	invalid := filepath.Join(t.TempDir(), "invalid.json")
+	assert.NoError(t, os.WriteFile(invalid, []byte("not json"), 0o600))
+	_, err = Load(invalid)
+	assert.Error(t, err)
+}
+
+func TestMerge(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	first := &TestsReport{
+		TimeStamp: start.Add(5 * time.Second),
+		Time:      "20.000",
+		Reports: []*TestReport{{
+			Name: "test-3",
+			Test: 1,
+		}},
+	}
+	second := &TestsReport{
+		TimeStamp: start,
+		Time:      "10.000",
+		Reports: []*TestReport{{
+			Name: "test-1",
+			Test: 1,
+		}, {
+			Name:    "test-2",
+			Test:    1,
+			Failure: &Failure{Message: "failed"},
+		}},
+	}
+	merged := Merge("merged", first, nil, second)
+	assert.Equal(t, "merged", merged.Name)
+	assert.True(t, start.Equal(merged.TimeStamp))
+	assert.Equal(t, "25.000", merged.Time)
+	assert.Equal(t, 3, merged.Test)
+	assert.Equal(t, 1, merged.Failures)
+	assert.Equal(t, []string{"test-3", "test-1", "test-2"}, []string{merged.Reports[0].Name, merged.Reports[1].Name, merged.Reports[2].Name})
+	// the rerun replaces the failed entry
+	rerun := &TestsReport{
+		TimeStamp: start.Add(time.Minute),
+		Time:      "5.000",
+		RerunOf:   "second.json",
+		Reports: []*TestReport{{
+			Name: "test-2",
+			Test: 1,
+		}},
+	}
+	merged = Merge("merged", rerun, first, second)
+	assert.Equal(t, 3, merged.Test)
+	assert.Equal(t, 0, merged.Failures)
+	assert.Equal(t, []string{"test-2", "test-3", "test-1"}, []string{merged.Reports[0].Name, merged.Reports[1].Name, merged.Reports[2].Name})
+	// different tests with the same name in different shards are all kept
+	shard := &TestsReport{
+		TimeStamp: start.Add(time.Minute),
+		Time:      "5.000",
+		Reports: []*TestReport{{
+			Name: "test-2",
+			Test: 1,
+		}},
+	}
+	merged = Merge("merged", shard, first, second)
+	assert.Equal(t, 4, merged.Test)
+	assert.Equal(t, 1, merged.Failures)
+	assert.Equal(t, []string{"test-2", "test-3", "test-1", "test-2"}, []string{merged.Reports[0].Name, merged.Reports[1].Name, merged.Reports[2].Name, merged.Reports[3].Name})
+	empty := Merge("empty")
+	assert.Equal(t, 0, empty.Test)
+	assert.NotNil(t, empty.Reports)
+}
+
+func TestTestsReport_Durations(t *testing.T) {
+	report := &TestsReport{
+		Reports: []*TestReport{{
+			Name: "test-1",
+			Time: "1.500",
+		}, {
+			Name: "test-1",
+			Time: "3.000",
+		}, {
+			Name: "test-2",
+			Time: "2.000",
+		}, {
+			Name: "test-3",
+			Time: "0.000",
+			Skip: true,
+		}},
+	}
+	assert.Equal(t, map[string]time.Duration{
+		"test-1": 3 * time.Second,
+		"test-2": 2 * time.Second,
+	}, report.Durations())
+}
+
+func TestTestsReport_FailedTests(t *testing.T) {
+	report := &TestsReport{
+		Reports: []*TestReport{{
+			Name: "test-1",
+		}, {
+			Name:    "test-2",
+			Failure: &Failure{Message: "failed"},
+		}, {
+			Name:    "test-2",
+			Failure: &Failure{Message: "failed"},
+		}, {
+			Name:    "test-3",
+			Failure: &Failure{Message: "failed"},
+		}},
+	}
+	assert.Equal(t, []string{"test-2", "test-3"}, report.FailedTests())
+	assert.Nil(t, (&TestsReport{}).FailedTests())
+}
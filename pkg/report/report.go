@@ -10,19 +10,29 @@ import (
 	"time"
 
 	v1alpha1 "github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"go.uber.org/multierr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 type OperationType string
 
 const (
-	OperationTypeCreate  OperationType = "create"
-	OperationTypeDelete  OperationType = "delete"
-	OperationTypeApply   OperationType = "apply"
-	OperationTypeAssert  OperationType = "assert"
-	OperationTypeError   OperationType = "error"
-	OperationTypeScript  OperationType = "script"
-	OperationTypeSleep   OperationType = "sleep"
-	OperationTypeCommand OperationType = "command"
+	OperationTypeCreate   OperationType = "create"
+	OperationTypeDelete   OperationType = "delete"
+	OperationTypeApply    OperationType = "apply"
+	OperationTypePatch    OperationType = "patch"
+	OperationTypeAssert   OperationType = "assert"
+	OperationTypeError    OperationType = "error"
+	OperationTypeScript   OperationType = "script"
+	OperationTypeSleep    OperationType = "sleep"
+	OperationTypeCommand  OperationType = "command"
+	OperationTypePodLogs  OperationType = "podLogs"
+	OperationTypeEvents   OperationType = "events"
+	OperationTypeWait     OperationType = "wait"
+	OperationTypeExec     OperationType = "exec"
+	OperationTypeHTTP     OperationType = "http"
+	OperationTypeGet      OperationType = "get"
+	OperationTypeDescribe OperationType = "describe"
 )
 
 type ReportSerializer interface {
@@ -33,6 +43,8 @@ type ReportSerializer interface {
 type Failure struct {
 	// Message provides a summary of the failure.
 	Message string `json:"message" xml:"message,attr"`
+	// Errors contains the messages of the failed operations.
+	Errors []string `json:"errors,omitempty" xml:"error,omitempty"`
 }
 
 // TestsReport encapsulates the entire report for a test suite.
@@ -49,6 +61,8 @@ type TestsReport struct {
 	Reports []*TestReport `json:"testsuite" xml:"testsuite"`
 	// Failures count the number of failed tests in the suite.
 	Failures int `json:"failures" xml:"failures,attr"`
+	// RerunOf is the path of the report whose failed tests were rerun, if the run was a rerun.
+	RerunOf string `json:"rerunOf,omitempty" xml:"rerunOf,attr,omitempty"`
 }
 
 // TestReport represents a report for a single test.
@@ -71,8 +85,16 @@ type TestReport struct {
 	Namespace string `json:"namespace,omitempty" xml:"namespace,attr,omitempty"`
 	// Skip indicates if the test is skipped.
 	Skip bool `json:"skip,omitempty" xml:"skip,attr,omitempty"`
+	// SkipReason explains why the test was skipped.
+	SkipReason string `json:"skipReason,omitempty" xml:"skipReason,attr,omitempty"`
 	// SkipDelete indicates if resources are not deleted after test execution.
 	SkipDelete bool `json:"skipDelete,omitempty" xml:"skipDelete,attr,omitempty"`
+	// Artifacts is the directory containing the artifacts produced by the test.
+	Artifacts string `json:"artifacts,omitempty" xml:"artifacts,attr,omitempty"`
+	// Attempts is the number of times the test was executed when retries are enabled.
+	Attempts int `json:"attempts,omitempty" xml:"attempts,attr,omitempty"`
+	// Flaky indicates if the test passed after being retried.
+	Flaky bool `json:"flaky,omitempty" xml:"flaky,attr,omitempty"`
 }
 
 // TestSpecStepReport represents a report of a single step in a test.
@@ -81,6 +103,10 @@ type TestSpecStepReport struct {
 	Name string `json:"name,omitempty" xml:"name,attr,omitempty"`
 	// Results are the outcomes of operations performed in this step.
 	Results []*OperationReport `json:"results,omitempty" xml:"results,omitempty"`
+	// Catch are the outcomes of catch operations performed in this step.
+	Catch []*OperationReport `json:"catch,omitempty" xml:"catch,omitempty"`
+	// Finally are the outcomes of finally operations performed in this step.
+	Finally []*OperationReport `json:"finally,omitempty" xml:"finally,omitempty"`
 }
 
 // OperationReport details the outcome of a single operation within a test step.
@@ -95,8 +121,28 @@ type OperationReport struct {
 	Result string `json:"result" xml:"result,attr"`
 	// Message provides additional information about the operation's outcome.
 	Message string `json:"message,omitempty" xml:"message,omitempty"`
+	// Errors contains the individual errors when the operation failed with multiple errors.
+	Errors []string `json:"errors,omitempty" xml:"error,omitempty"`
 	// Type indicates the type of operation.
 	OperationType OperationType `json:"operationType,omitempty" xml:"operationType,attr"`
+	// Resource identifies the resource the operation acted on.
+	Resource *ResourceReference `json:"resource,omitempty" xml:"resource,omitempty"`
+	// Artifacts are the paths of the files written by the operation.
+	Artifacts []string `json:"artifacts,omitempty" xml:"artifact,omitempty"`
+	// Output contains the sections logged by the operation (command output, errors, etc...).
+	Output []string `json:"output,omitempty" xml:"output,omitempty"`
+}
+
+// ResourceReference identifies a resource, as declared in the test.
+type ResourceReference struct {
+	// APIVersion of the resource.
+	APIVersion string `json:"apiVersion,omitempty" xml:"apiVersion,attr,omitempty"`
+	// Kind of the resource.
+	Kind string `json:"kind,omitempty" xml:"kind,attr,omitempty"`
+	// Namespace of the resource.
+	Namespace string `json:"namespace,omitempty" xml:"namespace,attr,omitempty"`
+	// Name of the resource.
+	Name string `json:"name,omitempty" xml:"name,attr,omitempty"`
 }
 
 type JSONSerializer struct{}
@@ -125,6 +171,12 @@ func GetSerializer(format v1alpha1.ReportFormatType) (ReportSerializer, error) {
 		return JSONSerializer{}, nil
 	case v1alpha1.XMLFormat:
 		return XMLSerializer{}, nil
+	case v1alpha1.JUnitFormat:
+		return JUnitSerializer{}, nil
+	case v1alpha1.HTMLFormat:
+		return HTMLSerializer{}, nil
+	case v1alpha1.MarkdownFormat:
+		return MarkdownSerializer{}, nil
 	default:
 		return nil, errors.New("unsupported report format")
 	}
@@ -135,8 +187,24 @@ func (report *TestsReport) SaveReportBasedOnType(reportFormat v1alpha1.ReportFor
 	if err != nil {
 		return err
 	}
-	filePath := reportName + "." + strings.ToLower(string(reportFormat))
-	return SaveReport(report, serializer, filePath)
+	return SaveReport(report, serializer, FilePath(reportFormat, reportName))
+}
+
+// FilePath returns the path of the file a report with the given format and name is saved to.
+func FilePath(reportFormat v1alpha1.ReportFormatType, reportName string) string {
+	return reportName + "." + extension(reportFormat)
+}
+
+// extension returns the file extension used for a report format.
+func extension(format v1alpha1.ReportFormatType) string {
+	switch format {
+	case v1alpha1.JUnitFormat:
+		return "xml"
+	case v1alpha1.MarkdownFormat:
+		return "md"
+	default:
+		return strings.ToLower(string(format))
+	}
 }
 
 // NewTests initializes a new TestsReport with the given name.
@@ -189,15 +257,79 @@ func (ts *TestSpecStepReport) AddOperation(op *OperationReport) {
 	ts.Results = append(ts.Results, op)
 }
 
-// NewFailure creates a new Failure instance with the given message and type and assigns it to the TestReport.
+// AddCatch adds a catch operation report to the TestSpecStepReport.
+func (ts *TestSpecStepReport) AddCatch(op *OperationReport) {
+	ts.Catch = append(ts.Catch, op)
+}
+
+// AddFinally adds a finally operation report to the TestSpecStepReport.
+func (ts *TestSpecStepReport) AddFinally(op *OperationReport) {
+	ts.Finally = append(ts.Finally, op)
+}
+
+// Operations returns all operation reports of the TestSpecStepReport, including catch and finally ones.
+func (ts *TestSpecStepReport) Operations() []*OperationReport {
+	var ops []*OperationReport
+	ops = append(ops, ts.Results...)
+	ops = append(ops, ts.Catch...)
+	ops = append(ops, ts.Finally...)
+	return ops
+}
+
+// SetResource sets the resource the OperationReport acted on.
+func (op *OperationReport) SetResource(obj unstructured.Unstructured) {
+	op.Resource = &ResourceReference{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// AddArtifacts adds artifact paths to the OperationReport.
+func (op *OperationReport) AddArtifacts(paths ...string) {
+	op.Artifacts = append(op.Artifacts, paths...)
+}
+
+// AddOutput adds logged sections to the OperationReport.
+func (op *OperationReport) AddOutput(sections ...string) {
+	op.Output = append(op.Output, sections...)
+}
+
+// NewFailure creates a new Failure instance with the given message and assigns it to the TestReport.
+// The messages of the failed operations are collected in the failure errors.
 func (t *TestReport) NewFailure(message string) {
 	if t.Failure == nil {
 		t.Failure = &Failure{
 			Message: message,
 		}
+		for _, step := range t.Steps {
+			for _, op := range step.Operations() {
+				if op.Result == "Failure" {
+					t.Failure.Errors = append(t.Failure.Errors, op.Name+": "+op.Message)
+				}
+			}
+		}
 	}
 }
 
+// NewAttempt starts a new attempt of the TestReport, the steps of the previous attempt are discarded.
+func (t *TestReport) NewAttempt() {
+	t.Attempts++
+	t.Steps = []*TestSpecStepReport{}
+}
+
+// MarkTestFlaky marks the TestReport as flaky.
+func (t *TestReport) MarkTestFlaky() {
+	t.Flaky = true
+}
+
+// MarkTestSkipped marks the TestReport as skipped for the given reason.
+func (t *TestReport) MarkTestSkipped(reason string) {
+	t.Skip = true
+	t.SkipReason = reason
+}
+
 // MarkTestEnd marks the end time of a TestReport and calculates its duration.
 func (t *TestReport) MarkTestEnd() {
 	t.Time = calculateDuration(t.TimeStamp, time.Now())
@@ -207,6 +339,11 @@ func (t *TestReport) MarkTestEnd() {
 	}
 }
 
+// MarkOperationStart marks the start time of an OperationReport.
+func (op *OperationReport) MarkOperationStart() {
+	op.TimeStamp = time.Now()
+}
+
 // MarkOperationEnd marks the end time of an OperationReport and calculates its duration.
 func (op *OperationReport) MarkOperationEnd(success bool, message string) {
 	op.Time = calculateDuration(op.TimeStamp, time.Now())
@@ -218,6 +355,16 @@ func (op *OperationReport) MarkOperationEnd(success bool, message string) {
 	op.Message = message
 }
 
+// MarkOperationError marks an OperationReport as failed, individual errors are recorded when err combines multiple errors.
+func (op *OperationReport) MarkOperationError(err error) {
+	op.MarkOperationEnd(false, err.Error())
+	if errs := multierr.Errors(err); len(errs) > 1 {
+		for _, err := range errs {
+			op.Errors = append(op.Errors, err.Error())
+		}
+	}
+}
+
 // calculateDuration calculates the duration between two time points.
 func calculateDuration(start, end time.Time) string {
 	return fmt.Sprintf("%.3f", end.Sub(start).Seconds())
@@ -226,12 +373,17 @@ func calculateDuration(start, end time.Time) string {
 // Close finalizes the TestsReport, marking its end time and calculating the overall duration.
 func (tr *TestsReport) Close() {
 	tr.Time = calculateDuration(tr.TimeStamp, time.Now())
-	totalTests := 0
+	tr.computeTotals()
+}
+
+// computeTotals computes the number of tests and failures of the TestsReport.
+func (tr *TestsReport) computeTotals() {
+	tr.Failures = 0
+	tr.Test = 0
 	for _, testReport := range tr.Reports {
 		if testReport.Failure != nil {
 			tr.Failures++
 		}
-		totalTests += testReport.Test
+		tr.Test += testReport.Test
 	}
-	tr.Test = totalTests
 }
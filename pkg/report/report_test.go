@@ -9,6 +9,7 @@ import (
 
 	v1alpha1 "github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/multierr"
 )
 
 type FakeSerializer struct{}
@@ -36,6 +37,12 @@ func TestSaveReport(t *testing.T) {
 			serializer:  XMLSerializer{},
 			expectError: false,
 		},
+		{
+			name:        "SuccessfulSaveJUnit",
+			reportName:  "test_report.xml",
+			serializer:  JUnitSerializer{},
+			expectError: false,
+		},
 		{
 			name:        "FailedSaveInvalidPathJSON",
 			reportName:  "/invalid_path/test_report.json",
@@ -96,6 +103,24 @@ func TestSaveReportBasedOnType(t *testing.T) {
 			fileSuffix:  "xml",
 			expectError: false,
 		},
+		{
+			name:        "SuccessfulSaveJUnit",
+			format:      v1alpha1.JUnitFormat,
+			fileSuffix:  "xml",
+			expectError: false,
+		},
+		{
+			name:        "SuccessfulSaveHTML",
+			format:      v1alpha1.HTMLFormat,
+			fileSuffix:  "html",
+			expectError: false,
+		},
+		{
+			name:        "SuccessfulSaveMarkdown",
+			format:      v1alpha1.MarkdownFormat,
+			fileSuffix:  "md",
+			expectError: false,
+		},
 		{
 			name:        "UnsupportedFormat",
 			format:      "Unsupported",
@@ -154,6 +179,15 @@ func TestAddOperation(t *testing.T) {
 	assert.Equal(t, operation, testSpecStep.Results[0], "The added operation does not match the expected operation")
 }
 
+func TestAddArtifacts(t *testing.T) {
+	operation := NewOperation("Operation1", OperationTypeCommand)
+
+	operation.AddArtifacts("artifacts/test/step/01-command-stdout.log")
+	operation.AddArtifacts("artifacts/test/step/02-command-stderr.log")
+
+	assert.Equal(t, []string{"artifacts/test/step/01-command-stdout.log", "artifacts/test/step/02-command-stderr.log"}, operation.Artifacts, "The artifacts do not match the expected artifacts")
+}
+
 func TestNewFailure(t *testing.T) {
 	testReport := NewTest("Test1")
 	testReport.NewFailure("Sample failure message")
@@ -233,3 +267,64 @@ func TestClose(t *testing.T) {
 	assert.Equal(t, 1, testsReport.Failures, "Failures count should be 1")
 	assert.Equal(t, 2, testsReport.Test, "Total tests count should be 2")
 }
+
+func TestMarkOperationError(t *testing.T) {
+	operation := NewOperation("Operation1", OperationTypeAssert)
+	operation.MarkOperationError(errors.New("single error"))
+	assert.Equal(t, "Failure", operation.Result)
+	assert.Equal(t, "single error", operation.Message)
+	assert.Nil(t, operation.Errors, "Errors should be nil for a single error")
+
+	operation = NewOperation("Operation2", OperationTypeAssert)
+	operation.MarkOperationError(multierr.Combine(errors.New("first error"), errors.New("second error")))
+	assert.Equal(t, "Failure", operation.Result)
+	assert.Equal(t, "first error; second error", operation.Message)
+	assert.Equal(t, []string{"first error", "second error"}, operation.Errors)
+}
+
+func TestNewFailure_Errors(t *testing.T) {
+	failed := NewOperation("Assert", OperationTypeAssert)
+	failed.MarkOperationEnd(false, "assert failed")
+	passed := NewOperation("Apply", OperationTypeApply)
+	passed.MarkOperationEnd(true, "Operation completed successfully")
+	catch := NewOperation("Script", OperationTypeScript)
+	catch.MarkOperationEnd(false, "script failed")
+	step := NewTestSpecStep("Step1")
+	step.AddOperation(passed)
+	step.AddOperation(failed)
+	step.AddCatch(catch)
+	testReport := NewTest("Test1")
+	testReport.AddTestStep(step)
+
+	testReport.NewFailure("test failed")
+
+	assert.Equal(t, "test failed", testReport.Failure.Message)
+	assert.Equal(t, []string{"Assert: assert failed", "Script: script failed"}, testReport.Failure.Errors)
+}
+
+func TestMarkTestSkipped(t *testing.T) {
+	testReport := NewTest("Test1")
+	testReport.MarkTestSkipped("test is marked as skipped")
+
+	assert.True(t, testReport.Skip)
+	assert.Equal(t, "test is marked as skipped", testReport.SkipReason)
+}
+
+func TestNewAttempt(t *testing.T) {
+	testReport := NewTest("Test1")
+	testReport.AddTestStep(NewTestSpecStep("step-1"))
+	testReport.NewAttempt()
+	assert.Equal(t, 1, testReport.Attempts)
+	assert.Empty(t, testReport.Steps)
+	testReport.AddTestStep(NewTestSpecStep("step-1"))
+	testReport.NewAttempt()
+	assert.Equal(t, 2, testReport.Attempts)
+	assert.Empty(t, testReport.Steps)
+}
+
+func TestMarkTestFlaky(t *testing.T) {
+	testReport := NewTest("Test1")
+	testReport.MarkTestFlaky()
+
+	assert.True(t, testReport.Flaky)
+}
@@ -0,0 +1,117 @@
+package report
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/multierr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+)
+
+// inline replaces local references with the referenced definitions, the validator doesn't support references.
+func inline(node any, definitions map[string]any) any {
+	switch node := node.(type) {
+	case map[string]any:
+		if ref, ok := node["$ref"].(string); ok {
+			return inline(definitions[strings.TrimPrefix(ref, "#/definitions/")], definitions)
+		}
+		out := map[string]any{}
+		for key, value := range node {
+			if key != "definitions" {
+				out[key] = inline(value, definitions)
+			}
+		}
+		return out
+	case []any:
+		var out []any
+		for _, value := range node {
+			out = append(out, inline(value, definitions))
+		}
+		return out
+	default:
+		return node
+	}
+}
+
+func loadSchema(t *testing.T) *spec.Schema {
+	t.Helper()
+	data, err := os.ReadFile("schema.json")
+	assert.NoError(t, err)
+	var document map[string]any
+	assert.NoError(t, json.Unmarshal(data, &document))
+	definitions, _ := document["definitions"].(map[string]any)
+	data, err = json.Marshal(inline(document, definitions))
+	assert.NoError(t, err)
+	var schema spec.Schema
+	assert.NoError(t, json.Unmarshal(data, &schema))
+	return &schema
+}
+
+func validateReport(t *testing.T, schema *spec.Schema, report *TestsReport) []error {
+	t.Helper()
+	data, err := JSONSerializer{}.Serialize(report)
+	assert.NoError(t, err)
+	var document any
+	assert.NoError(t, json.Unmarshal(data, &document))
+	return validate.NewSchemaValidator(schema, nil, "", strfmt.Default).Validate(document).Errors
+}
+
+func TestJSONSchema(t *testing.T) {
+	schema := loadSchema(t)
+	var resource unstructured.Unstructured
+	resource.SetAPIVersion("v1")
+	resource.SetKind("ConfigMap")
+	resource.SetNamespace("default")
+	resource.SetName("quick-start")
+	passed := NewOperation("Apply ConfigMap/quick-start", OperationTypeApply)
+	passed.SetResource(resource)
+	passed.AddArtifacts("artifacts/test/01-step/01-resources.yaml")
+	passed.MarkOperationEnd(true, "Operation completed successfully")
+	failed := NewOperation("Assert ConfigMap/quick-start", OperationTypeAssert)
+	failed.SetResource(resource)
+	failed.MarkOperationError(multierr.Combine(errors.New("data.foo: Invalid value"), errors.New("data.bar: Invalid value")))
+	catch := NewOperation("Events", OperationTypeEvents)
+	catch.MarkOperationEnd(true, "Operation completed successfully")
+	finally := NewOperation("Script", OperationTypeScript)
+	finally.MarkOperationEnd(true, "Operation completed successfully")
+	step := NewTestSpecStep("step-1")
+	step.AddOperation(passed)
+	step.AddOperation(failed)
+	step.AddCatch(catch)
+	step.AddFinally(finally)
+	failing := NewTest("failing")
+	failing.Namespace = "chainsaw-foo"
+	failing.Artifacts = "artifacts/failing"
+	failing.AddTestStep(step)
+	failing.NewFailure("test failed")
+	failing.MarkTestEnd()
+	skipped := NewTest("skipped")
+	skipped.MarkTestSkipped("test is marked as skipped")
+	skipped.MarkTestEnd()
+	report := NewTests("chainsaw-report")
+	report.AddTest(failing)
+	report.AddTest(skipped)
+	report.Close()
+	assert.Empty(t, validateReport(t, schema, report))
+	// make sure the schema actually rejects unknown fields
+	data, err := JSONSerializer{}.Serialize(report)
+	assert.NoError(t, err)
+	var document map[string]any
+	assert.NoError(t, json.Unmarshal(data, &document))
+	document["unknown"] = true
+	assert.NotEmpty(t, validate.NewSchemaValidator(schema, nil, "", strfmt.Default).Validate(document).Errors)
+}
+
+func TestJSONSchema_Empty(t *testing.T) {
+	schema := loadSchema(t)
+	report := &TestsReport{Name: "empty", TimeStamp: time.Now()}
+	assert.Empty(t, validateReport(t, schema, report))
+}
@@ -0,0 +1,20 @@
+package artifacts
+
+import (
+	"context"
+)
+
+type contextKey struct{}
+
+func FromContext(ctx context.Context) Store {
+	if ctx != nil {
+		if v, ok := ctx.Value(contextKey{}).(Store); ok {
+			return v
+		}
+	}
+	return nil
+}
+
+func IntoContext(ctx context.Context, store Store) context.Context {
+	return context.WithValue(ctx, contextKey{}, store)
+}
@@ -0,0 +1,91 @@
+package artifacts
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+)
+
+var unsafeChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// Store writes artifacts produced by operations to disk.
+type Store interface {
+	// Write writes data to a new artifact file derived from name and returns the file path.
+	Write(name string, data []byte) (string, error)
+}
+
+type store struct {
+	dir   string
+	lock  sync.Mutex
+	count int
+}
+
+// New returns a Store writing artifacts in dir, the directory is created when the first artifact is written.
+func New(dir string) Store {
+	return &store{
+		dir: dir,
+	}
+}
+
+func (s *store) Write(name string, data []byte) (string, error) {
+	s.lock.Lock()
+	s.count++
+	path := filepath.Join(s.dir, fmt.Sprintf("%02d-%s", s.count, Sanitize(name)))
+	s.lock.Unlock()
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// Recorder is a Store keeping track of the artifacts written through it.
+type Recorder struct {
+	inner Store
+	lock  sync.Mutex
+	paths []string
+}
+
+// NewRecorder returns a Recorder writing artifacts to the given store.
+func NewRecorder(inner Store) *Recorder {
+	return &Recorder{
+		inner: inner,
+	}
+}
+
+func (r *Recorder) Write(name string, data []byte) (string, error) {
+	path, err := r.inner.Write(name, data)
+	if err != nil {
+		return "", err
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.paths = append(r.paths, path)
+	return path, nil
+}
+
+// Paths returns the paths of the artifacts written so far.
+func (r *Recorder) Paths() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]string(nil), r.paths...)
+}
+
+// Write writes an artifact using the store in the context, it does nothing if the context has no store.
+func Write(ctx context.Context, name string, data []byte) error {
+	if store := FromContext(ctx); store != nil {
+		_, err := store.Write(name, data)
+		return err
+	}
+	return nil
+}
+
+// Sanitize replaces characters that are not safe in file names.
+func Sanitize(name string) string {
+	return unsafeChars.ReplaceAllString(name, "-")
+}
@@ -0,0 +1,66 @@
+package artifacts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_store_Write(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "test", "step")
+	store := New(dir)
+	first, err := store.Write("pod-logs.log", []byte("foo"))
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "01-pod-logs.log"), first)
+	second, err := store.Write("command stdout.log", []byte("bar"))
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "02-command-stdout.log"), second)
+	data, err := os.ReadFile(first)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", string(data))
+	data, err = os.ReadFile(second)
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", string(data))
+}
+
+func TestRecorder(t *testing.T) {
+	dir := t.TempDir()
+	recorder := NewRecorder(New(dir))
+	assert.Nil(t, recorder.Paths())
+	path, err := recorder.Write("events.log", []byte("foo"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{path}, recorder.Paths())
+}
+
+func TestWrite(t *testing.T) {
+	assert.NoError(t, Write(context.TODO(), "events.log", []byte("foo")))
+	dir := t.TempDir()
+	recorder := NewRecorder(New(dir))
+	ctx := IntoContext(context.TODO(), recorder)
+	assert.NoError(t, Write(ctx, "events.log", []byte("foo")))
+	assert.Equal(t, []string{filepath.Join(dir, "01-events.log")}, recorder.Paths())
+}
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{{
+		name: "foo",
+		want: "foo",
+	}, {
+		name: "foo/bar baz",
+		want: "foo-bar-baz",
+	}, {
+		name: "step-1.log",
+		want: "step-1.log",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Sanitize(tt.name))
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package bindings
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	runnertemplate "github.com/kyverno/chainsaw/pkg/runner/template"
+	"github.com/kyverno/kyverno-json/pkg/engine/template"
+)
+
+var expression = regexp.MustCompile(`^\((.+)\)$`)
+
+func RegisterNamedBinding(bindings binding.Bindings, name string, value any) binding.Bindings {
+	if bindings == nil {
+		bindings = binding.NewBindings()
+	}
+	return bindings.Register("$"+name, binding.NewBinding(value))
+}
+
+func RegisterBinding(ctx context.Context, bindings binding.Bindings, obj any, input v1alpha1.Binding) (binding.Bindings, error) {
+	value, err := Evaluate(ctx, input.Value.Value, obj, bindings)
+	if err != nil {
+		return bindings, fmt.Errorf("failed to evaluate binding %s (%w)", input.Name, err)
+	}
+	return RegisterNamedBinding(bindings, input.Name, value), nil
+}
+
+func RegisterBindings(ctx context.Context, bindings binding.Bindings, obj any, inputs ...v1alpha1.Binding) (binding.Bindings, error) {
+	for _, input := range inputs {
+		registered, err := RegisterBinding(ctx, bindings, obj, input)
+		if err != nil {
+			return bindings, err
+		}
+		bindings = registered
+	}
+	return bindings, nil
+}
+
+// Execute evaluates the JMESPath expression against obj.
+func Execute(ctx context.Context, expression string, obj any, bindings binding.Bindings) (any, error) {
+	if bindings == nil {
+		bindings = binding.NewBindings()
+	}
+	return template.Execute(ctx, expression, obj, bindings, template.WithFunctionCaller(runnertemplate.Caller))
+}
+
+// Evaluate walks the given value and evaluates strings enclosed in parenthesis as JMESPath expressions
+// against obj, other values are returned as is.
+func Evaluate(ctx context.Context, value any, obj any, bindings binding.Bindings) (any, error) {
+	if bindings == nil {
+		bindings = binding.NewBindings()
+	}
+	switch typed := value.(type) {
+	case string:
+		if match := expression.FindStringSubmatch(typed); match != nil {
+			return Execute(ctx, match[1], obj, bindings)
+		}
+		return typed, nil
+	case map[string]any:
+		out := make(map[string]any, len(typed))
+		for key, value := range typed {
+			evaluated, err := Evaluate(ctx, value, obj, bindings)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = evaluated
+		}
+		return out, nil
+	case []any:
+		out := make([]any, 0, len(typed))
+		for _, value := range typed {
+			evaluated, err := Evaluate(ctx, value, obj, bindings)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, evaluated)
+		}
+		return out, nil
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,165 @@
+package bindings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluate(t *testing.T) {
+	bindings := RegisterNamedBinding(nil, "foo", "bar")
+	tests := []struct {
+		name     string
+		value    any
+		obj      any
+		bindings binding.Bindings
+		want     any
+		wantErr  bool
+	}{{
+		name:  "nil",
+		value: nil,
+		want:  nil,
+	}, {
+		name:  "literal",
+		value: 42,
+		want:  42,
+	}, {
+		name:  "literal string",
+		value: "foo",
+		want:  "foo",
+	}, {
+		name:     "binding",
+		value:    "($foo)",
+		bindings: bindings,
+		want:     "bar",
+	}, {
+		name:  "object",
+		value: "(metadata.name)",
+		obj: map[string]any{
+			"metadata": map[string]any{
+				"name": "chainsaw",
+			},
+		},
+		want: "chainsaw",
+	}, {
+		name:  "function",
+		value: "(to_upper('foo'))",
+		want:  "FOO",
+	}, {
+		name: "nested",
+		value: map[string]any{
+			"list": []any{"($foo)", "baz"},
+		},
+		bindings: bindings,
+		want: map[string]any{
+			"list": []any{"bar", "baz"},
+		},
+	}, {
+		name:    "invalid expression",
+		value:   "(foo[)",
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Evaluate(context.TODO(), tt.value, tt.obj, tt.bindings)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRegisterBindings(t *testing.T) {
+	bindings, err := RegisterBindings(context.TODO(), nil, nil,
+		v1alpha1.Binding{Name: "foo", Value: v1alpha1.Check{Value: "bar"}},
+		v1alpha1.Binding{Name: "baz", Value: v1alpha1.Check{Value: "(concat($foo, '-baz'))"}},
+	)
+	assert.NoError(t, err)
+	foo, err := bindings.Get("$foo")
+	assert.NoError(t, err)
+	value, err := foo.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", value)
+	baz, err := bindings.Get("$baz")
+	assert.NoError(t, err)
+	value, err = baz.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "bar-baz", value)
+	_, err = RegisterBindings(context.TODO(), nil, nil, v1alpha1.Binding{Name: "foo", Value: v1alpha1.Check{Value: "(foo[)"}})
+	assert.Error(t, err)
+}
+
+func TestProcessOutputs(t *testing.T) {
+	obj := map[string]any{
+		"metadata": map[string]any{
+			"name": "chainsaw",
+			"uid":  "1234",
+		},
+	}
+	tests := []struct {
+		name     string
+		bindings binding.Bindings
+		obj      any
+		outputs  []v1alpha1.Output
+		want     map[string]any
+		wantErr  bool
+	}{{
+		name: "none",
+		obj:  obj,
+		want: nil,
+	}, {
+		name: "outputs",
+		obj:  obj,
+		outputs: []v1alpha1.Output{{
+			Binding: v1alpha1.Binding{Name: "uid", Value: v1alpha1.Check{Value: "(metadata.uid)"}},
+		}, {
+			Binding: v1alpha1.Binding{Name: "id", Value: v1alpha1.Check{Value: "(join('-', [metadata.name, $uid]))"}},
+		}},
+		want: map[string]any{
+			"uid": "1234",
+			"id":  "chainsaw-1234",
+		},
+	}, {
+		name:     "with match",
+		bindings: RegisterNamedBinding(nil, "stdout", "hello"),
+		obj:      obj,
+		outputs: []v1alpha1.Output{{
+			Binding: v1alpha1.Binding{Name: "out", Value: v1alpha1.Check{Value: "($stdout)"}},
+			Match:   &v1alpha1.Check{Value: map[string]any{"metadata": map[string]any{"name": "chainsaw"}}},
+		}, {
+			Binding: v1alpha1.Binding{Name: "skipped", Value: v1alpha1.Check{Value: "foo"}},
+			Match:   &v1alpha1.Check{Value: map[string]any{"metadata": map[string]any{"name": "other"}}},
+		}},
+		want: map[string]any{
+			"out": "hello",
+		},
+	}, {
+		name: "error",
+		obj:  obj,
+		outputs: []v1alpha1.Output{{
+			Binding: v1alpha1.Binding{Name: "uid", Value: v1alpha1.Check{Value: "(metadata[)"}},
+		}},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ProcessOutputs(context.TODO(), tt.bindings, tt.obj, tt.outputs...)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				if tt.want == nil {
+					assert.Nil(t, got)
+				} else {
+					assert.Equal(t, tt.want, map[string]any(got))
+				}
+			}
+		})
+	}
+}
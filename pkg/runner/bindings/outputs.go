@@ -0,0 +1,34 @@
+package bindings
+
+import (
+	"context"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/runner/check"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+)
+
+func ProcessOutputs(ctx context.Context, bindings binding.Bindings, obj any, outputs ...v1alpha1.Output) (operations.Outputs, error) {
+	if len(outputs) == 0 {
+		return nil, nil
+	}
+	results := operations.Outputs{}
+	for _, output := range outputs {
+		// if a match is specified, skip the output if the object doesn't match
+		if output.Match != nil && output.Match.Value != nil {
+			if errs, err := check.Check(ctx, obj, bindings, output.Match); err != nil {
+				return nil, err
+			} else if len(errs) != 0 {
+				continue
+			}
+		}
+		value, err := Evaluate(ctx, output.Value.Value, obj, bindings)
+		if err != nil {
+			return nil, err
+		}
+		bindings = RegisterNamedBinding(bindings, output.Name, value)
+		results[output.Name] = value
+	}
+	return results, nil
+}
@@ -16,7 +16,7 @@ func Expectations(ctx context.Context, obj unstructured.Unstructured, bindings b
 	for _, expectation := range expect {
 		// if a match is specified, skip the check if the resource doesn't match
 		if expectation.Match != nil && expectation.Match.Value != nil {
-			if errs, err := Check(ctx, obj.UnstructuredContent(), nil, expectation.Match); err != nil {
+			if errs, err := Check(ctx, obj.UnstructuredContent(), bindings, expectation.Match); err != nil {
 				return true, err
 			} else if len(errs) != 0 {
 				continue
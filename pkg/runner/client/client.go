@@ -8,6 +8,7 @@ import (
 	"github.com/kyverno/kyverno/ext/output/color"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -72,6 +73,10 @@ func (c *runnerClient) Patch(ctx context.Context, obj ctrlclient.Object, patch c
 	return c.inner.Patch(ctx, obj, patch, opts...)
 }
 
+func (c *runnerClient) Watch(ctx context.Context, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) (watch.Interface, error) {
+	return c.inner.Watch(ctx, list, opts...)
+}
+
 func (c *runnerClient) IsObjectNamespaced(obj runtime.Object) (bool, error) {
 	return c.inner.IsObjectNamespaced(obj)
 }
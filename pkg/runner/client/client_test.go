@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -393,6 +394,82 @@ func Test_runnerClient_List(t *testing.T) {
 	}
 }
 
+func Test_runnerClient_Watch(t *testing.T) {
+	type args struct {
+		obj  ctrlclient.ObjectList
+		opts []ctrlclient.ListOption
+	}
+	tests := []struct {
+		name        string
+		logger      func(t *testing.T) *tlogging.FakeLogger
+		inner       func(t *testing.T) *tclient.FakeClient
+		args        args
+		wantErr     bool
+		innerCalls  int
+		loggerCalls int
+	}{{
+		name: "with error",
+		logger: func(t *testing.T) *tlogging.FakeLogger {
+			t.Helper()
+			return &tlogging.FakeLogger{}
+		},
+		inner: func(t *testing.T) *tclient.FakeClient {
+			t.Helper()
+			return &tclient.FakeClient{
+				WatchFn: func(_ context.Context, _ int, _ ctrlclient.ObjectList, _ ...ctrlclient.ListOption) (watch.Interface, error) {
+					return nil, errors.New("test")
+				},
+			}
+		},
+		args: args{
+			obj:  &unstructured.Unstructured{},
+			opts: nil,
+		},
+		wantErr:     true,
+		loggerCalls: 0,
+		innerCalls:  1,
+	}, {
+		name: "no error",
+		logger: func(t *testing.T) *tlogging.FakeLogger {
+			t.Helper()
+			return &tlogging.FakeLogger{}
+		},
+		inner: func(t *testing.T) *tclient.FakeClient {
+			t.Helper()
+			return &tclient.FakeClient{
+				WatchFn: func(_ context.Context, _ int, _ ctrlclient.ObjectList, _ ...ctrlclient.ListOption) (watch.Interface, error) {
+					return watch.NewFake(), nil
+				},
+			}
+		},
+		args: args{
+			obj:  &unstructured.Unstructured{},
+			opts: nil,
+		},
+		wantErr:     false,
+		loggerCalls: 0,
+		innerCalls:  1,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockLogger := tt.logger(t)
+			mockClient := tt.inner(t)
+			c := &runnerClient{
+				inner: mockClient,
+			}
+			ctx := logging.IntoContext(context.TODO(), mockLogger)
+			_, err := c.Watch(ctx, tt.args.obj, tt.args.opts...)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.innerCalls, mockClient.NumCalls())
+			assert.Equal(t, tt.loggerCalls, mockLogger.NumCalls())
+		})
+	}
+}
+
 func Test_runnerClient_Patch(t *testing.T) {
 	type args struct {
 		obj   ctrlclient.Object
@@ -13,20 +13,29 @@ type (
 const (
 	Apply    Operation = "APPLY"
 	Assert   Operation = "ASSERT"
+	Bindings Operation = "BINDINGS"
 	Catch    Operation = "CATCH"
 	Command  Operation = "CMD"
 	Create   Operation = "CREATE"
 	Delete   Operation = "DELETE"
+	Describe Operation = "DESCRIBE"
+	Dump     Operation = "DUMP"
 	Error    Operation = "ERROR"
+	Events   Operation = "EVENTS"
+	Exec     Operation = "EXEC"
 	Finally  Operation = "FINALLY"
 	Get      Operation = "GET"
+	HTTP     Operation = "HTTP"
 	Internal Operation = "INTERNAL"
 	Patch    Operation = "PATCH"
+	PodLogs  Operation = "LOGS"
+	Retry    Operation = "RETRY"
 	Script   Operation = "SCRIPT"
 	Sleep    Operation = "SLEEP"
 	Stderr   Operation = "STDERR"
 	Stdout   Operation = "STDOUT"
 	Try      Operation = "TRY"
+	Wait     Operation = "WAIT"
 )
 
 const (
@@ -0,0 +1,60 @@
+package logging
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/kyverno/kyverno/ext/output/color"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type records struct {
+	lock     sync.Mutex
+	sections []string
+}
+
+// Recorder is a Logger keeping track of the sections logged through it.
+type Recorder struct {
+	inner   Logger
+	records *records
+}
+
+// NewRecorder returns a Recorder forwarding logs to the given logger, inner can be nil.
+func NewRecorder(inner Logger) *Recorder {
+	return &Recorder{
+		inner:   inner,
+		records: &records{},
+	}
+}
+
+func (r *Recorder) Log(operation Operation, status Status, color *color.Color, args ...fmt.Stringer) {
+	if r.inner != nil {
+		r.inner.Log(operation, status, color, args...)
+	}
+	r.records.lock.Lock()
+	defer r.records.lock.Unlock()
+	for _, arg := range args {
+		if arg != nil {
+			r.records.sections = append(r.records.sections, arg.String())
+		}
+	}
+}
+
+func (r *Recorder) WithResource(resource ctrlclient.Object) Logger {
+	var inner Logger
+	if r.inner != nil {
+		inner = r.inner.WithResource(resource)
+	}
+	// loggers derived from the recorder share its records
+	return &Recorder{
+		inner:   inner,
+		records: r.records,
+	}
+}
+
+// Sections returns the sections logged so far.
+func (r *Recorder) Sections() []string {
+	r.records.lock.Lock()
+	defer r.records.lock.Unlock()
+	return append([]string(nil), r.records.sections...)
+}
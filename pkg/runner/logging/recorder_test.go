@@ -0,0 +1,27 @@
+package logging
+
+import (
+	"testing"
+
+	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRecorder(t *testing.T) {
+	inner := &tlogging.FakeLogger{}
+	recorder := NewRecorder(inner)
+	recorder.Log(Command, RunStatus, nil, Section("COMMAND", "echo foo"))
+	recorder.WithResource(&unstructured.Unstructured{}).Log(Command, LogStatus, nil, Section("STDOUT", "foo"), Section("STDERR", "bar"))
+	assert.Equal(t, []string{"=== COMMAND\necho foo", "=== STDOUT\nfoo", "=== STDERR\nbar"}, recorder.Sections())
+	assert.Equal(t, []string{
+		"CMD: RUN - [=== COMMAND\necho foo]",
+		"CMD: LOG - [=== STDOUT\nfoo === STDERR\nbar]",
+	}, inner.Logs)
+}
+
+func TestRecorder_NilInner(t *testing.T) {
+	recorder := NewRecorder(nil)
+	recorder.WithResource(nil).Log(Command, LogStatus, nil, Section("STDOUT", "foo"))
+	assert.Equal(t, []string{"=== STDOUT\nfoo"}, recorder.Sections())
+}
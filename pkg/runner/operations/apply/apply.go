@@ -3,102 +3,165 @@ package apply
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/jmespath-community/go-jmespath/pkg/binding"
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/client"
+	runnerbindings "github.com/kyverno/chainsaw/pkg/runner/bindings"
 	"github.com/kyverno/chainsaw/pkg/runner/check"
 	"github.com/kyverno/chainsaw/pkg/runner/cleanup"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
 	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
 	"github.com/kyverno/chainsaw/pkg/runner/operations"
 	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/chainsaw/pkg/runner/template"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/wait"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type operation struct {
-	client     client.Client
-	obj        unstructured.Unstructured
-	namespacer namespacer.Namespacer
-	cleaner    cleanup.Cleaner
-	expect     []v1alpha1.Expectation
+	client         client.Client
+	obj            unstructured.Unstructured
+	namespacer     namespacer.Namespacer
+	cleaner        cleanup.Cleaner
+	template       bool
+	polling        v1alpha1.Polling
+	serverSide     bool
+	fieldManager   string
+	forceConflicts bool
+	outputs        []v1alpha1.Output
+	expect         []v1alpha1.Expectation
 }
 
-func New(client client.Client, obj unstructured.Unstructured, namespacer namespacer.Namespacer, cleaner cleanup.Cleaner, expect ...v1alpha1.Expectation) operations.Operation {
+func New(
+	client client.Client,
+	obj unstructured.Unstructured,
+	namespacer namespacer.Namespacer,
+	cleaner cleanup.Cleaner,
+	template bool,
+	polling v1alpha1.Polling,
+	serverSide bool,
+	fieldManager string,
+	forceConflicts bool,
+	outputs []v1alpha1.Output,
+	expect ...v1alpha1.Expectation,
+) operations.Operation {
 	return &operation{
-		client:     client,
-		obj:        obj,
-		namespacer: namespacer,
-		cleaner:    cleaner,
-		expect:     expect,
+		client:         client,
+		obj:            obj,
+		namespacer:     namespacer,
+		cleaner:        cleaner,
+		template:       template,
+		polling:        polling,
+		serverSide:     serverSide,
+		fieldManager:   fieldManager,
+		forceConflicts: forceConflicts,
+		outputs:        outputs,
+		expect:         expect,
 	}
 }
 
-func (o *operation) Exec(ctx context.Context) (err error) {
+func (o *operation) Exec(ctx context.Context, bindings binding.Bindings) (_ operations.Outputs, err error) {
 	logger := internal.GetLogger(ctx, &o.obj)
 	defer func() {
 		internal.LogEnd(logger, logging.Apply, err)
 	}()
+	if o.template {
+		if err := template.Resource(ctx, &o.obj, bindings); err != nil {
+			return nil, err
+		}
+	}
 	if err := internal.ApplyNamespacer(o.namespacer, &o.obj); err != nil {
-		return err
+		return nil, err
 	}
 	internal.LogStart(logger, logging.Apply)
-	return o.execute(ctx)
+	return o.execute(ctx, bindings)
 }
 
-func (o *operation) execute(ctx context.Context) error {
-	return wait.PollUntilContextCancel(ctx, internal.PollInterval, false, func(ctx context.Context) (bool, error) {
-		err := o.tryApplyResource(ctx)
+func (o *operation) execute(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error) {
+	var outputs operations.Outputs
+	err := internal.Poll(ctx, o.polling, false, func(ctx context.Context) (bool, error) {
+		_outputs, err := o.tryApplyResource(ctx, bindings)
+		outputs = _outputs
 		return err == nil, err
 	})
+	return outputs, err
 }
 
-func (o *operation) tryApplyResource(ctx context.Context) error {
+func (o *operation) tryApplyResource(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error) {
 	var actual unstructured.Unstructured
 	actual.SetGroupVersionKind(o.obj.GetObjectKind().GroupVersionKind())
 	err := o.client.Get(ctx, client.ObjectKey(&o.obj), &actual)
 	if err == nil {
-		return o.updateResource(ctx, &actual)
+		if o.serverSide {
+			return o.serverSideApplyResource(ctx, bindings, false)
+		}
+		return o.updateResource(ctx, bindings, &actual)
 	}
 	if kerrors.IsNotFound(err) {
-		return o.createResource(ctx)
+		if o.serverSide {
+			return o.serverSideApplyResource(ctx, bindings, true)
+		}
+		return o.createResource(ctx, bindings)
+	}
+	return nil, err
+}
+
+func (o *operation) serverSideApplyResource(ctx context.Context, bindings binding.Bindings, created bool) (operations.Outputs, error) {
+	obj := o.obj.DeepCopy()
+	opts := []ctrlclient.PatchOption{ctrlclient.FieldOwner(o.fieldManager)}
+	if o.forceConflicts {
+		opts = append(opts, ctrlclient.ForceOwnership)
 	}
-	return err
+	err := o.client.Patch(ctx, obj, ctrlclient.Apply, opts...)
+	if err == nil && created && o.cleaner != nil {
+		o.cleaner(o.obj, o.client)
+	}
+	if kerrors.IsConflict(err) {
+		err = fmt.Errorf("server-side apply conflict with field manager %s (set forceConflicts to take ownership): %w", o.fieldManager, err)
+	}
+	return o.handleCheck(ctx, bindings, *obj, err)
 }
 
-func (o *operation) updateResource(ctx context.Context, actual *unstructured.Unstructured) error {
+func (o *operation) updateResource(ctx context.Context, bindings binding.Bindings, actual *unstructured.Unstructured) (operations.Outputs, error) {
 	patched, err := client.PatchObject(actual, &o.obj)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	bytes, err := json.Marshal(patched)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return o.handleCheck(ctx, o.client.Patch(ctx, actual, ctrlclient.RawPatch(types.MergePatchType, bytes)))
+	err = o.client.Patch(ctx, actual, ctrlclient.RawPatch(types.MergePatchType, bytes))
+	return o.handleCheck(ctx, bindings, *actual, err)
 }
 
-func (o *operation) createResource(ctx context.Context) error {
+func (o *operation) createResource(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error) {
 	err := o.client.Create(ctx, &o.obj)
 	if err == nil && o.cleaner != nil {
 		o.cleaner(o.obj, o.client)
 	}
-	return o.handleCheck(ctx, err)
+	return o.handleCheck(ctx, bindings, o.obj, err)
 }
 
-func (o *operation) handleCheck(ctx context.Context, err error) error {
-	bindings := binding.NewBindings()
+func (o *operation) handleCheck(ctx context.Context, bindings binding.Bindings, obj unstructured.Unstructured, err error) (operations.Outputs, error) {
+	if bindings == nil {
+		bindings = binding.NewBindings()
+	}
 	if err == nil {
 		bindings = bindings.Register("$error", binding.NewBinding(nil))
 	} else {
 		bindings = bindings.Register("$error", binding.NewBinding(err.Error()))
 	}
-	if matched, err := check.Expectations(ctx, o.obj, bindings, o.expect...); matched {
-		return err
+	if matched, checkErr := check.Expectations(ctx, o.obj, bindings, o.expect...); matched {
+		if checkErr != nil {
+			return nil, checkErr
+		}
+	} else if err != nil {
+		return nil, err
 	}
-	return err
+	return runnerbindings.ProcessOutputs(ctx, bindings, obj.UnstructuredContent(), o.outputs...)
 }
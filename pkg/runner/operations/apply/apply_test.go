@@ -9,9 +9,11 @@ import (
 	tclient "github.com/kyverno/chainsaw/pkg/client/testing"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
 	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
 	"github.com/stretchr/testify/assert"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -51,11 +53,15 @@ func Test_apply(t *testing.T) {
 		},
 	}
 	tests := []struct {
-		name        string
-		object      unstructured.Unstructured
-		client      *tclient.FakeClient
-		expect      []v1alpha1.Expectation
-		expectedErr error
+		name           string
+		object         unstructured.Unstructured
+		client         *tclient.FakeClient
+		serverSide     bool
+		forceConflicts bool
+		outputs        []v1alpha1.Output
+		expect         []v1alpha1.Expectation
+		expectedErr    error
+		expectedOut    operations.Outputs
 	}{{
 		name:   "Resource already exists, patch it",
 		object: podv2,
@@ -284,6 +290,113 @@ func Test_apply(t *testing.T) {
 		},
 		expect:      nil,
 		expectedErr: nil,
+	}, {
+		name:   "Server side apply existing resource",
+		object: podv2,
+		client: &tclient.FakeClient{
+			GetFn: func(ctx context.Context, _ int, _ ctrlclient.ObjectKey, obj ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+				*obj.(*unstructured.Unstructured) = podv1
+				return nil
+			},
+			PatchFn: func(_ context.Context, _ int, _ ctrlclient.Object, patch ctrlclient.Patch, opts ...ctrlclient.PatchOption) error {
+				if patch.Type() != types.ApplyPatchType {
+					return errors.New("unexpected patch type")
+				}
+				var options ctrlclient.PatchOptions
+				options.ApplyOptions(opts)
+				if options.FieldManager != "chainsaw" || options.Force != nil {
+					return errors.New("unexpected patch options")
+				}
+				return nil
+			},
+		},
+		serverSide:  true,
+		expect:      nil,
+		expectedErr: nil,
+	}, {
+		name:   "Server side apply non-existing resource",
+		object: podv1,
+		client: &tclient.FakeClient{
+			GetFn: func(ctx context.Context, _ int, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+				return kerrors.NewNotFound(obj.GetObjectKind().GroupVersionKind().GroupVersion().WithResource("pods").GroupResource(), key.Name)
+			},
+			PatchFn: func(_ context.Context, _ int, _ ctrlclient.Object, patch ctrlclient.Patch, _ ...ctrlclient.PatchOption) error {
+				if patch.Type() != types.ApplyPatchType {
+					return errors.New("unexpected patch type")
+				}
+				return nil
+			},
+		},
+		serverSide:  true,
+		expect:      nil,
+		expectedErr: nil,
+	}, {
+		name:   "Server side apply with force conflicts",
+		object: podv2,
+		client: &tclient.FakeClient{
+			GetFn: func(ctx context.Context, _ int, _ ctrlclient.ObjectKey, obj ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+				*obj.(*unstructured.Unstructured) = podv1
+				return nil
+			},
+			PatchFn: func(_ context.Context, _ int, _ ctrlclient.Object, _ ctrlclient.Patch, opts ...ctrlclient.PatchOption) error {
+				var options ctrlclient.PatchOptions
+				options.ApplyOptions(opts)
+				if options.Force == nil || !*options.Force {
+					return errors.New("expected force option")
+				}
+				return nil
+			},
+		},
+		serverSide:     true,
+		forceConflicts: true,
+		expect:         nil,
+		expectedErr:    nil,
+	}, {
+		name:   "Server side apply conflict",
+		object: podv2,
+		client: &tclient.FakeClient{
+			GetFn: func(ctx context.Context, _ int, _ ctrlclient.ObjectKey, obj ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+				*obj.(*unstructured.Unstructured) = podv1
+				return nil
+			},
+			PatchFn: func(_ context.Context, _ int, obj ctrlclient.Object, _ ctrlclient.Patch, _ ...ctrlclient.PatchOption) error {
+				return kerrors.NewConflict(obj.GetObjectKind().GroupVersionKind().GroupVersion().WithResource("pods").GroupResource(), obj.GetName(), errors.New("conflict with \"kubectl\""))
+			},
+		},
+		serverSide:  true,
+		expect:      nil,
+		expectedErr: errors.New(`server-side apply conflict with field manager chainsaw (set forceConflicts to take ownership): Operation cannot be fulfilled on pods "test-pod": conflict with "kubectl"`),
+	}, {
+		name:   "Resource does not exist, create it with outputs",
+		object: *podv1.DeepCopy(),
+		client: &tclient.FakeClient{
+			GetFn: func(ctx context.Context, _ int, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+				return kerrors.NewNotFound(obj.GetObjectKind().GroupVersionKind().GroupVersion().WithResource("pod").GroupResource(), key.Name)
+			},
+			CreateFn: func(_ context.Context, _ int, obj ctrlclient.Object, _ ...ctrlclient.CreateOption) error {
+				obj.SetUID("test-uid")
+				return nil
+			},
+		},
+		outputs: []v1alpha1.Output{{
+			Binding: v1alpha1.Binding{
+				Name:  "uid",
+				Value: v1alpha1.Check{Value: "(metadata.uid)"},
+			},
+		}, {
+			Binding: v1alpha1.Binding{
+				Name:  "skipped",
+				Value: v1alpha1.Check{Value: "foo"},
+			},
+			Match: &v1alpha1.Check{
+				Value: map[string]any{
+					"kind": "Service",
+				},
+			},
+		}},
+		expectedOut: operations.Outputs{
+			"uid": "test-uid",
+		},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -294,14 +407,21 @@ func Test_apply(t *testing.T) {
 				tt.object,
 				nil,
 				nil,
+				false,
+				v1alpha1.Polling{},
+				tt.serverSide,
+				v1alpha1.DefaultFieldManager,
+				tt.forceConflicts,
+				tt.outputs,
 				tt.expect...,
 			)
-			err := operation.Exec(ctx)
+			outputs, err := operation.Exec(ctx, nil)
 			if tt.expectedErr != nil {
 				assert.EqualError(t, err, tt.expectedErr.Error())
 			} else {
 				assert.NoError(t, err)
 			}
+			assert.Equal(t, tt.expectedOut, outputs)
 		})
 	}
 }
@@ -5,48 +5,62 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/client"
+	runnerbindings "github.com/kyverno/chainsaw/pkg/runner/bindings"
 	"github.com/kyverno/chainsaw/pkg/runner/check"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
 	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
 	"github.com/kyverno/chainsaw/pkg/runner/operations"
 	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/chainsaw/pkg/runner/template"
 	"go.uber.org/multierr"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 type operation struct {
 	client     client.Client
 	expected   unstructured.Unstructured
 	namespacer namespacer.Namespacer
+	template   bool
+	polling    v1alpha1.Polling
+	outputs    []v1alpha1.Output
 }
 
-func New(client client.Client, expected unstructured.Unstructured, namespacer namespacer.Namespacer) operations.Operation {
+func New(client client.Client, expected unstructured.Unstructured, namespacer namespacer.Namespacer, template bool, polling v1alpha1.Polling, outputs []v1alpha1.Output) operations.Operation {
 	return &operation{
 		client:     client,
 		expected:   expected,
 		namespacer: namespacer,
+		template:   template,
+		polling:    polling,
+		outputs:    outputs,
 	}
 }
 
-func (o *operation) Exec(ctx context.Context) (err error) {
+func (o *operation) Exec(ctx context.Context, bindings binding.Bindings) (_ operations.Outputs, err error) {
 	logger := internal.GetLogger(ctx, &o.expected)
 	defer func() {
 		internal.LogEnd(logger, logging.Assert, err)
 	}()
+	if o.template {
+		if err := template.Resource(ctx, &o.expected, bindings); err != nil {
+			return nil, err
+		}
+	}
 	if err := internal.ApplyNamespacer(o.namespacer, &o.expected); err != nil {
-		return err
+		return nil, err
 	}
 	internal.LogStart(logger, logging.Assert)
-	return o.execute(ctx)
+	return o.execute(ctx, bindings)
 }
 
-func (o *operation) execute(ctx context.Context) error {
+func (o *operation) execute(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error) {
 	var lastErrs []error
-	err := wait.PollUntilContextCancel(ctx, internal.PollInterval, false, func(ctx context.Context) (_ bool, err error) {
+	var match *unstructured.Unstructured
+	err := internal.WaitFor(ctx, &o.expected, o.client, o.polling, false, func(ctx context.Context) (_ bool, err error) {
 		var errs []error
 		defer func() {
 			// record last errors only if there was no real error
@@ -65,7 +79,7 @@ func (o *operation) execute(ctx context.Context) error {
 		} else {
 			for i := range candidates {
 				candidate := candidates[i]
-				_errs, err := check.Check(ctx, candidate.UnstructuredContent(), nil, &v1alpha1.Check{Value: o.expected.UnstructuredContent()})
+				_errs, err := check.Check(ctx, candidate.UnstructuredContent(), bindings, &v1alpha1.Check{Value: o.expected.UnstructuredContent()})
 				if err != nil {
 					return false, err
 				}
@@ -75,6 +89,7 @@ func (o *operation) execute(ctx context.Context) error {
 					}
 				} else {
 					// at least one match found
+					match = &candidate
 					return true, nil
 				}
 			}
@@ -83,12 +98,12 @@ func (o *operation) execute(ctx context.Context) error {
 	})
 	// if no error, return success
 	if err == nil {
-		return nil
+		return runnerbindings.ProcessOutputs(ctx, bindings, match.UnstructuredContent(), o.outputs...)
 	}
 	// eventually return a combination of last errors
 	if len(lastErrs) != 0 {
-		return multierr.Combine(lastErrs...)
+		return nil, multierr.Combine(lastErrs...)
 	}
 	// return received error
-	return err
+	return nil, err
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/client"
 	tclient "github.com/kyverno/chainsaw/pkg/client/testing"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
@@ -316,9 +317,12 @@ func Test_operationAssert(t *testing.T) {
 				tt.client,
 				tt.expected,
 				nspacer,
+				false,
+				v1alpha1.Polling{},
+				nil,
 			)
 			logger := &tlogging.FakeLogger{}
-			err := operation.Exec(ttesting.IntoContext(logging.IntoContext(ctx, logger), t))
+			_, err := operation.Exec(ttesting.IntoContext(logging.IntoContext(ctx, logger), t), nil)
 			if tt.expectErr {
 				assert.NotNil(t, err)
 			} else {
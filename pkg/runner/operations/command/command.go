@@ -8,6 +8,7 @@ import (
 
 	"github.com/jmespath-community/go-jmespath/pkg/binding"
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	runnerbindings "github.com/kyverno/chainsaw/pkg/runner/bindings"
 	"github.com/kyverno/chainsaw/pkg/runner/check"
 	"github.com/kyverno/chainsaw/pkg/runner/env"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
@@ -30,17 +31,17 @@ func New(command v1alpha1.Command, basePath string, namespace string) operations
 	}
 }
 
-func (o *operation) Exec(ctx context.Context) (_err error) {
+func (o *operation) Exec(ctx context.Context, bindings binding.Bindings) (_ operations.Outputs, _err error) {
 	logger := internal.GetLogger(ctx, nil)
 	defer func() {
 		internal.LogEnd(logger, logging.Command, _err)
 	}()
 	cmd, err := o.createCommand(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	internal.LogStart(logger, logging.Command, logging.Section("COMMAND", cmd.String()))
-	return o.execute(ctx, cmd)
+	return o.execute(ctx, bindings, cmd)
 }
 
 func (o *operation) createCommand(ctx context.Context) (*exec.Cmd, error) {
@@ -60,7 +61,7 @@ func (o *operation) createCommand(ctx context.Context) (*exec.Cmd, error) {
 	return cmd, nil
 }
 
-func (o *operation) execute(ctx context.Context, cmd *exec.Cmd) error {
+func (o *operation) execute(ctx context.Context, bindings binding.Bindings, cmd *exec.Cmd) (operations.Outputs, error) {
 	logger := logging.FromContext(ctx)
 	var output internal.CommandOutput
 	if !o.command.SkipLogOutput {
@@ -73,10 +74,14 @@ func (o *operation) execute(ctx context.Context, cmd *exec.Cmd) error {
 	cmd.Stdout = &output.Stdout
 	cmd.Stderr = &output.Stderr
 	err := cmd.Run()
-	if o.command.Check == nil || o.command.Check.Value == nil {
-		return err
+	if !o.command.SkipLogOutput {
+		if err := output.Save(ctx, "command"); err != nil {
+			return nil, err
+		}
+	}
+	if bindings == nil {
+		bindings = binding.NewBindings()
 	}
-	bindings := binding.NewBindings()
 	if err == nil {
 		bindings = bindings.Register("$error", binding.NewBinding(nil))
 	} else {
@@ -84,9 +89,14 @@ func (o *operation) execute(ctx context.Context, cmd *exec.Cmd) error {
 	}
 	bindings = bindings.Register("$stdout", binding.NewBinding(output.Out()))
 	bindings = bindings.Register("$stderr", binding.NewBinding(output.Err()))
-	if errs, err := check.Check(ctx, nil, bindings, o.command.Check); err != nil {
-		return err
-	} else {
-		return errs.ToAggregate()
+	if o.command.Check == nil || o.command.Check.Value == nil {
+		if err != nil {
+			return nil, err
+		}
+	} else if errs, err := check.Check(ctx, nil, bindings, o.command.Check); err != nil {
+		return nil, err
+	} else if err := errs.ToAggregate(); err != nil {
+		return nil, err
 	}
+	return runnerbindings.ProcessOutputs(ctx, bindings, nil, o.command.Outputs...)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
 	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,6 +17,7 @@ func Test_operationCommand(t *testing.T) {
 		command   v1alpha1.Command
 		basePath  string
 		namespace string
+		want      operations.Outputs
 		wantErr   bool
 	}{{
 		name: "Test with valid Command",
@@ -98,6 +100,23 @@ func Test_operationCommand(t *testing.T) {
 		basePath:  "..",
 		namespace: "test-namespace",
 		wantErr:   true,
+	}, {
+		name: "with outputs",
+		command: v1alpha1.Command{
+			Entrypoint:    "echo",
+			Args:          []string{"hello"},
+			SkipLogOutput: true,
+			Outputs: []v1alpha1.Output{{
+				Binding: v1alpha1.Binding{
+					Name:  "greeting",
+					Value: v1alpha1.Check{Value: "($stdout)"},
+				},
+			}},
+		},
+		namespace: "test-namespace",
+		want: map[string]any{
+			"greeting": "hello",
+		},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -107,12 +126,13 @@ func Test_operationCommand(t *testing.T) {
 				tt.basePath,
 				tt.namespace,
 			)
-			err := operation.Exec(ctx)
+			outputs, err := operation.Exec(ctx, nil)
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 			}
+			assert.Equal(t, tt.want, outputs)
 		})
 	}
 }
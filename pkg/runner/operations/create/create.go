@@ -13,9 +13,9 @@ import (
 	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
 	"github.com/kyverno/chainsaw/pkg/runner/operations"
 	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/chainsaw/pkg/runner/template"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 type operation struct {
@@ -23,39 +23,48 @@ type operation struct {
 	obj        unstructured.Unstructured
 	namespacer namespacer.Namespacer
 	cleaner    cleanup.Cleaner
+	template   bool
+	polling    v1alpha1.Polling
 	expect     []v1alpha1.Expectation
 }
 
-func New(client client.Client, obj unstructured.Unstructured, namespacer namespacer.Namespacer, cleaner cleanup.Cleaner, expect ...v1alpha1.Expectation) operations.Operation {
+func New(client client.Client, obj unstructured.Unstructured, namespacer namespacer.Namespacer, cleaner cleanup.Cleaner, template bool, polling v1alpha1.Polling, expect ...v1alpha1.Expectation) operations.Operation {
 	return &operation{
 		client:     client,
 		obj:        obj,
 		namespacer: namespacer,
 		cleaner:    cleaner,
+		template:   template,
+		polling:    polling,
 		expect:     expect,
 	}
 }
 
-func (o *operation) Exec(ctx context.Context) (err error) {
+func (o *operation) Exec(ctx context.Context, bindings binding.Bindings) (_ operations.Outputs, err error) {
 	logger := internal.GetLogger(ctx, &o.obj)
 	defer func() {
 		internal.LogEnd(logger, logging.Create, err)
 	}()
+	if o.template {
+		if err := template.Resource(ctx, &o.obj, bindings); err != nil {
+			return nil, err
+		}
+	}
 	if err := internal.ApplyNamespacer(o.namespacer, &o.obj); err != nil {
-		return err
+		return nil, err
 	}
 	internal.LogStart(logger, logging.Create)
-	return o.createResource(ctx, logger)
+	return nil, o.createResource(ctx, bindings)
 }
 
-func (o *operation) createResource(ctx context.Context, logger logging.Logger) error {
-	return wait.PollUntilContextCancel(ctx, internal.PollInterval, false, func(ctx context.Context) (bool, error) {
-		err := o.tryCreateResource(ctx)
+func (o *operation) createResource(ctx context.Context, bindings binding.Bindings) error {
+	return internal.Poll(ctx, o.polling, false, func(ctx context.Context) (bool, error) {
+		err := o.tryCreateResource(ctx, bindings)
 		return err == nil, err
 	})
 }
 
-func (o *operation) tryCreateResource(ctx context.Context) error {
+func (o *operation) tryCreateResource(ctx context.Context, bindings binding.Bindings) error {
 	var actual unstructured.Unstructured
 	actual.SetGroupVersionKind(o.obj.GetObjectKind().GroupVersionKind())
 	err := o.client.Get(ctx, client.ObjectKey(&o.obj), &actual)
@@ -63,21 +72,23 @@ func (o *operation) tryCreateResource(ctx context.Context) error {
 		return errors.New("the resource already exists in the cluster")
 	}
 	if kerrors.IsNotFound(err) {
-		return o.create_Resource(ctx)
+		return o.create_Resource(ctx, bindings)
 	}
 	return err
 }
 
-func (o *operation) create_Resource(ctx context.Context) error {
+func (o *operation) create_Resource(ctx context.Context, bindings binding.Bindings) error {
 	err := o.client.Create(ctx, &o.obj)
 	if err == nil && o.cleaner != nil {
 		o.cleaner(o.obj, o.client)
 	}
-	return o.handleCheck(ctx, err)
+	return o.handleCheck(ctx, bindings, err)
 }
 
-func (o *operation) handleCheck(ctx context.Context, err error) error {
-	bindings := binding.NewBindings()
+func (o *operation) handleCheck(ctx context.Context, bindings binding.Bindings, err error) error {
+	if bindings == nil {
+		bindings = binding.NewBindings()
+	}
 	if err == nil {
 		bindings = bindings.Register("$error", binding.NewBinding(nil))
 	} else {
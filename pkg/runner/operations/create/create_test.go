@@ -39,6 +39,7 @@ func Test_create(t *testing.T) {
 		object      unstructured.Unstructured
 		client      *tclient.FakeClient
 		cleaner     cleanup.Cleaner
+		template    bool
 		expect      []v1alpha1.Expectation
 		expectedErr error
 	}{{
@@ -209,6 +210,44 @@ func Test_create(t *testing.T) {
 			},
 		}},
 		expectedErr: errors.New(`kind: Invalid value: "Pod": Expected value: "Service"`),
+	}, {
+		name: "Templated resource",
+		object: unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata": map[string]any{
+					"name": "{{ join('-', ['test', 'pod']) }}",
+				},
+			},
+		},
+		client: &tclient.FakeClient{
+			GetFn: func(ctx context.Context, _ int, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+				return kerrors.NewNotFound(obj.GetObjectKind().GroupVersionKind().GroupVersion().WithResource("pod").GroupResource(), key.Name)
+			},
+			CreateFn: func(_ context.Context, _ int, obj ctrlclient.Object, _ ...ctrlclient.CreateOption) error {
+				if obj.GetName() != "test-pod" {
+					return errors.New("resource was not templated")
+				}
+				return nil
+			},
+		},
+		template:    true,
+		expectedErr: nil,
+	}, {
+		name: "Invalid template",
+		object: unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata": map[string]any{
+					"name": "{{ foo[ }}",
+				},
+			},
+		},
+		client:      &tclient.FakeClient{},
+		template:    true,
+		expectedErr: errors.New("failed to evaluate foo[ (SyntaxError: Expected TOKStar, received: TOKEOF)"),
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -219,9 +258,11 @@ func Test_create(t *testing.T) {
 				tt.object,
 				nil,
 				tt.cleaner,
+				tt.template,
+				v1alpha1.Polling{},
 				tt.expect...,
 			)
-			err := operation.Exec(ctx)
+			_, err := operation.Exec(ctx, nil)
 			if tt.expectedErr != nil {
 				assert.EqualError(t, err, tt.expectedErr.Error())
 			} else {
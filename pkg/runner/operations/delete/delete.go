@@ -11,46 +11,55 @@ import (
 	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
 	"github.com/kyverno/chainsaw/pkg/runner/operations"
 	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/chainsaw/pkg/runner/template"
 	"go.uber.org/multierr"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 type operation struct {
 	client     client.Client
 	obj        unstructured.Unstructured
 	namespacer namespacer.Namespacer
+	template   bool
+	polling    v1alpha1.Polling
 	expect     []v1alpha1.Expectation
 }
 
-func New(client client.Client, obj unstructured.Unstructured, namespacer namespacer.Namespacer, expect ...v1alpha1.Expectation) operations.Operation {
+func New(client client.Client, obj unstructured.Unstructured, namespacer namespacer.Namespacer, template bool, polling v1alpha1.Polling, expect ...v1alpha1.Expectation) operations.Operation {
 	return &operation{
 		client:     client,
 		obj:        obj,
 		namespacer: namespacer,
+		template:   template,
+		polling:    polling,
 		expect:     expect,
 	}
 }
 
-func (o *operation) Exec(ctx context.Context) (err error) {
+func (o *operation) Exec(ctx context.Context, bindings binding.Bindings) (_ operations.Outputs, err error) {
 	logger := internal.GetLogger(ctx, &o.obj)
 	defer func() {
 		internal.LogEnd(logger, logging.Delete, err)
 	}()
+	if o.template {
+		if err := template.Resource(ctx, &o.obj, bindings); err != nil {
+			return nil, err
+		}
+	}
 	if err := internal.ApplyNamespacer(o.namespacer, &o.obj); err != nil {
-		return err
+		return nil, err
 	}
 	internal.LogStart(logger, logging.Delete)
-	return o.execute(ctx)
+	return nil, o.execute(ctx, bindings)
 }
 
-func (o *operation) execute(ctx context.Context) error {
+func (o *operation) execute(ctx context.Context, bindings binding.Bindings) error {
 	resources, err := o.getResourcesToDelete(ctx)
 	if err != nil {
 		return err
 	}
-	return o.deleteResources(ctx, resources...)
+	return o.deleteResources(ctx, bindings, resources...)
 }
 
 func (o *operation) getResourcesToDelete(ctx context.Context) ([]unstructured.Unstructured, error) {
@@ -64,7 +73,7 @@ func (o *operation) getResourcesToDelete(ctx context.Context) ([]unstructured.Un
 	return resources, nil
 }
 
-func (o *operation) deleteResources(ctx context.Context, resources ...unstructured.Unstructured) error {
+func (o *operation) deleteResources(ctx context.Context, bindings binding.Bindings, resources ...unstructured.Unstructured) error {
 	var errs []error
 	var deleted []unstructured.Unstructured
 	for _, resource := range resources {
@@ -74,7 +83,7 @@ func (o *operation) deleteResources(ctx context.Context, resources ...unstructur
 			deleted = append(deleted, resource)
 		}
 		// check if the result was the expected one
-		if err := o.handleCheck(ctx, resource, err); err != nil {
+		if err := o.handleCheck(ctx, bindings, resource, err); err != nil {
 			errs = append(errs, err)
 		}
 	}
@@ -99,7 +108,7 @@ func (o *operation) deleteResource(ctx context.Context, resource unstructured.Un
 func (o *operation) waitForDeletion(ctx context.Context, resource unstructured.Unstructured) error {
 	gvk := resource.GetObjectKind().GroupVersionKind()
 	key := client.ObjectKey(&resource)
-	return wait.PollUntilContextCancel(ctx, internal.PollInterval, true, func(ctx context.Context) (bool, error) {
+	return internal.WaitFor(ctx, &resource, o.client, o.polling, true, func(ctx context.Context) (bool, error) {
 		var actual unstructured.Unstructured
 		actual.SetGroupVersionKind(gvk)
 		if err := o.client.Get(ctx, key, &actual); err != nil {
@@ -112,8 +121,10 @@ func (o *operation) waitForDeletion(ctx context.Context, resource unstructured.U
 	})
 }
 
-func (o *operation) handleCheck(ctx context.Context, resource unstructured.Unstructured, err error) error {
-	bindings := binding.NewBindings()
+func (o *operation) handleCheck(ctx context.Context, bindings binding.Bindings, resource unstructured.Unstructured, err error) error {
+	if bindings == nil {
+		bindings = binding.NewBindings()
+	}
 	if err == nil {
 		bindings = bindings.Register("$error", binding.NewBinding(nil))
 	} else {
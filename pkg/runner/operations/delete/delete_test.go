@@ -170,10 +170,12 @@ func Test_operationDelete(t *testing.T) {
 				tt.client,
 				tt.object,
 				nspacer,
+				false,
+				v1alpha1.Polling{},
 				tt.expect...,
 			)
 			logger := &tlogging.FakeLogger{}
-			err := operation.Exec(ttesting.IntoContext(logging.IntoContext(ctx, logger), t))
+			_, err := operation.Exec(ttesting.IntoContext(logging.IntoContext(ctx, logger), t), nil)
 			if tt.expectedErr != nil {
 				assert.EqualError(t, err, tt.expectedErr.Error())
 			} else {
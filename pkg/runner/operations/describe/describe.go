@@ -0,0 +1,144 @@
+package describe
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/client"
+	"github.com/kyverno/chainsaw/pkg/runner/artifacts"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/kyverno/ext/output/color"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+type operation struct {
+	client     client.Client
+	obj        unstructured.Unstructured
+	namespacer namespacer.Namespacer
+}
+
+// New returns an operation describing the objects matching obj, along with their related events.
+func New(client client.Client, obj unstructured.Unstructured, namespacer namespacer.Namespacer) operations.Operation {
+	return &operation{
+		client:     client,
+		obj:        obj,
+		namespacer: namespacer,
+	}
+}
+
+func (o *operation) Exec(ctx context.Context, _ binding.Bindings) (_ operations.Outputs, err error) {
+	logger := internal.GetLogger(ctx, &o.obj)
+	defer func() {
+		internal.LogEnd(logger, logging.Describe, err)
+	}()
+	if err := internal.ApplyNamespacer(o.namespacer, &o.obj); err != nil {
+		return nil, err
+	}
+	internal.LogStart(logger, logging.Describe)
+	return nil, o.execute(ctx)
+}
+
+func (o *operation) execute(ctx context.Context) error {
+	resources, err := internal.Read(ctx, &o.obj, o.client)
+	if err != nil {
+		return err
+	}
+	var descriptions []string
+	for _, resource := range resources {
+		var events corev1.EventList
+		if err := o.client.List(ctx, &events, ctrlclient.InNamespace(resource.GetNamespace()), ctrlclient.MatchingFields{"involvedObject.uid": string(resource.GetUID())}); err != nil {
+			return err
+		}
+		description, err := describe(resource, events.Items)
+		if err != nil {
+			return err
+		}
+		descriptions = append(descriptions, description)
+	}
+	if len(descriptions) != 0 {
+		content := strings.Join(descriptions, "\n\n")
+		if logger := logging.FromContext(ctx); logger != nil {
+			logger.Log(logging.Describe, logging.LogStatus, color.BoldFgCyan, logging.Section("DESCRIBE", content))
+		}
+		return artifacts.Write(ctx, "describe.log", []byte(content+"\n"))
+	}
+	return nil
+}
+
+func describe(resource unstructured.Unstructured, events []corev1.Event) (string, error) {
+	var buffer bytes.Buffer
+	w := tabwriter.NewWriter(&buffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Name:\t%s\n", resource.GetName())
+	if resource.GetNamespace() != "" {
+		fmt.Fprintf(w, "Namespace:\t%s\n", resource.GetNamespace())
+	}
+	fmt.Fprintf(w, "API Version:\t%s\n", resource.GetAPIVersion())
+	fmt.Fprintf(w, "Kind:\t%s\n", resource.GetKind())
+	writeMap(w, "Labels", resource.GetLabels())
+	writeMap(w, "Annotations", resource.GetAnnotations())
+	if created := resource.GetCreationTimestamp(); !created.IsZero() {
+		fmt.Fprintf(w, "Creation Timestamp:\t%s\n", created.UTC().Format(time.RFC3339))
+	}
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+	var fields []string
+	for field := range resource.Object {
+		if field != "apiVersion" && field != "kind" && field != "metadata" {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		data, err := yaml.Marshal(resource.Object[field])
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&buffer, "%s:\n%s", strings.ToUpper(field[:1])+field[1:], indent(strings.TrimSpace(string(data))))
+	}
+	if len(events) == 0 {
+		fmt.Fprint(&buffer, "Events:  <none>")
+	} else {
+		fmt.Fprintf(&buffer, "Events:\n%s", indent(strings.TrimSpace(internal.FormatEvents(events))))
+	}
+	return strings.TrimSpace(buffer.String()), nil
+}
+
+func writeMap(w *tabwriter.Writer, name string, values map[string]string) {
+	if len(values) == 0 {
+		fmt.Fprintf(w, "%s:\t<none>\n", name)
+		return
+	}
+	var keys []string
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
+		if i == 0 {
+			fmt.Fprintf(w, "%s:\t%s=%s\n", name, key, values[key])
+		} else {
+			fmt.Fprintf(w, "\t%s=%s\n", key, values[key])
+		}
+	}
+}
+
+func indent(content string) string {
+	var buffer strings.Builder
+	for _, line := range strings.Split(content, "\n") {
+		buffer.WriteString("  " + line + "\n")
+	}
+	return buffer.String()
+}
@@ -0,0 +1,132 @@
+package describe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tclient "github.com/kyverno/chainsaw/pkg/client/testing"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	ttesting "github.com/kyverno/chainsaw/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_operation_Exec(t *testing.T) {
+	pod := unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]any{
+				"name":              "pod-1",
+				"namespace":         "foo",
+				"uid":               "1234",
+				"creationTimestamp": "2024-01-01T10:00:00Z",
+				"labels": map[string]any{
+					"app":  "foo",
+					"tier": "web",
+				},
+			},
+			"spec": map[string]any{
+				"nodeName": "node-1",
+			},
+			"status": map[string]any{
+				"phase": "Running",
+			},
+		},
+	}
+	event := corev1.Event{
+		InvolvedObject: corev1.ObjectReference{
+			Kind: "Pod",
+			Name: "pod-1",
+		},
+		Type:          corev1.EventTypeNormal,
+		Reason:        "Scheduled",
+		Message:       "Successfully assigned foo/pod-1",
+		LastTimestamp: metav1.NewTime(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)),
+	}
+	description := "Name:                pod-1\n" +
+		"Namespace:           foo\n" +
+		"API Version:         v1\n" +
+		"Kind:                Pod\n" +
+		"Labels:              app=foo\n" +
+		"                     tier=web\n" +
+		"Annotations:         <none>\n" +
+		"Creation Timestamp:  2024-01-01T10:00:00Z\n" +
+		"Spec:\n" +
+		"  nodeName: node-1\n" +
+		"Status:\n" +
+		"  phase: Running\n"
+	tests := []struct {
+		name         string
+		events       []corev1.Event
+		listErr      error
+		expectedErr  string
+		expectedLogs []string
+	}{{
+		name: "without events",
+		expectedLogs: []string{
+			"DESCRIBE: RUN - []",
+			"DESCRIBE: LOG - [=== DESCRIBE\n" + description + "Events:  <none>]",
+			"DESCRIBE: DONE - []",
+		},
+	}, {
+		name:   "with events",
+		events: []corev1.Event{event},
+		expectedLogs: []string{
+			"DESCRIBE: RUN - []",
+			"DESCRIBE: LOG - [=== DESCRIBE\n" + description + "Events:\n" +
+				"  LAST SEEN              TYPE     REASON      OBJECT      MESSAGE\n" +
+				"  2024-01-01T10:00:00Z   Normal   Scheduled   pod/pod-1   Successfully assigned foo/pod-1]",
+			"DESCRIBE: DONE - []",
+		},
+	}, {
+		name:        "list error",
+		listErr:     errors.New("internal error"),
+		expectedErr: "internal error",
+		expectedLogs: []string{
+			"DESCRIBE: RUN - []",
+			"DESCRIBE: ERROR - [=== ERROR\ninternal error]",
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &tclient.FakeClient{
+				ListFn: func(_ context.Context, _ int, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) error {
+					var options ctrlclient.ListOptions
+					options.ApplyOptions(opts)
+					assert.Equal(t, "foo", options.Namespace)
+					switch list := list.(type) {
+					case *unstructured.UnstructuredList:
+						if tt.listErr != nil {
+							return tt.listErr
+						}
+						list.Items = []unstructured.Unstructured{*pod.DeepCopy()}
+					case *corev1.EventList:
+						assert.Equal(t, "involvedObject.uid=1234", options.FieldSelector.String())
+						list.Items = tt.events
+					}
+					return nil
+				},
+			}
+			var obj unstructured.Unstructured
+			obj.SetAPIVersion("v1")
+			obj.SetKind("Pod")
+			obj.SetNamespace("foo")
+			operation := New(client, obj, nil)
+			logger := &tlogging.FakeLogger{}
+			_, err := operation.Exec(ttesting.IntoContext(logging.IntoContext(context.TODO(), logger), t), nil)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedLogs, logger.Logs)
+		})
+	}
+}
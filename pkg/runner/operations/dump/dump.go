@@ -0,0 +1,131 @@
+package dump
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/client"
+	"github.com/kyverno/chainsaw/pkg/runner/artifacts"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/kyverno/ext/output/color"
+	"go.uber.org/multierr"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// skipped contains the resources that are not dumped, events are collected separately
+// and secrets should not end up in logs.
+var skipped = []schema.GroupResource{
+	{Group: "", Resource: "events"},
+	{Group: "events.k8s.io", Resource: "events"},
+	{Group: "", Resource: "secrets"},
+}
+
+type operation struct {
+	client    client.Client
+	discovery discovery.DiscoveryInterface
+	namespace string
+	objects   []unstructured.Unstructured
+}
+
+// New returns an operation dumping all objects in the namespace, plus the given (cluster scoped) objects.
+func New(client client.Client, discovery discovery.DiscoveryInterface, namespace string, objects ...unstructured.Unstructured) operations.Operation {
+	return &operation{
+		client:    client,
+		discovery: discovery,
+		namespace: namespace,
+		objects:   objects,
+	}
+}
+
+func (o *operation) Exec(ctx context.Context, _ binding.Bindings) (_ operations.Outputs, err error) {
+	logger := internal.GetLogger(ctx, nil)
+	defer func() {
+		internal.LogEnd(logger, logging.Dump, err)
+	}()
+	internal.LogStart(logger, logging.Dump)
+	return nil, o.execute(ctx)
+}
+
+func (o *operation) execute(ctx context.Context) error {
+	var errs []error
+	var resources []unstructured.Unstructured
+	if o.namespace != "" {
+		namespaced, err := o.namespaced(ctx)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		resources = append(resources, namespaced...)
+	}
+	for _, obj := range o.objects {
+		var actual unstructured.Unstructured
+		actual.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+		if err := o.client.Get(ctx, client.ObjectKey(&obj), &actual); err != nil {
+			if !kerrors.IsNotFound(err) {
+				errs = append(errs, err)
+			}
+		} else {
+			resources = append(resources, actual)
+		}
+	}
+	if len(resources) != 0 {
+		content, err := internal.FormatResources(resources)
+		if err != nil {
+			return multierr.Combine(append(errs, err)...)
+		}
+		if logger := logging.FromContext(ctx); logger != nil {
+			logger.Log(logging.Dump, logging.LogStatus, color.BoldFgCyan, logging.Section("RESOURCES", content))
+		}
+		if err := artifacts.Write(ctx, "resources.yaml", []byte(content+"\n")); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return multierr.Combine(errs...)
+}
+
+func (o *operation) namespaced(ctx context.Context) ([]unstructured.Unstructured, error) {
+	lists, err := o.discovery.ServerPreferredNamespacedResources()
+	// discovery can return partial results when some groups are not available
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+	var resources []unstructured.Unstructured
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, resource := range list.APIResources {
+			if !slices.Contains(resource.Verbs, "list") || slices.Contains(skipped, gv.WithResource(resource.Name).GroupResource()) {
+				continue
+			}
+			var items unstructured.UnstructuredList
+			items.SetGroupVersionKind(gv.WithKind(resource.Kind + "List"))
+			if err := o.client.List(ctx, &items, ctrlclient.InNamespace(o.namespace)); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			resources = append(resources, items.Items...)
+		}
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		ki, kj := resources[i].GroupVersionKind().String(), resources[j].GroupVersionKind().String()
+		if ki != kj {
+			return ki < kj
+		}
+		return resources[i].GetName() < resources[j].GetName()
+	})
+	return resources, multierr.Combine(errs...)
+}
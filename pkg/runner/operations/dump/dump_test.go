@@ -0,0 +1,163 @@
+package dump
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tclient "github.com/kyverno/chainsaw/pkg/client/testing"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	ttesting "github.com/kyverno/chainsaw/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type fakeDiscovery struct {
+	discovery.DiscoveryInterface
+	resources []*metav1.APIResourceList
+	err       error
+}
+
+func (d *fakeDiscovery) ServerPreferredNamespacedResources() ([]*metav1.APIResourceList, error) {
+	return d.resources, d.err
+}
+
+func Test_operation_Exec(t *testing.T) {
+	resources := []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{
+			Name:       "configmaps",
+			Namespaced: true,
+			Kind:       "ConfigMap",
+			Verbs:      []string{"get", "list"},
+		}, {
+			Name:       "secrets",
+			Namespaced: true,
+			Kind:       "Secret",
+			Verbs:      []string{"get", "list"},
+		}, {
+			Name:       "events",
+			Namespaced: true,
+			Kind:       "Event",
+			Verbs:      []string{"get", "list"},
+		}, {
+			Name:       "bindings",
+			Namespaced: true,
+			Kind:       "Binding",
+			Verbs:      []string{"create"},
+		}},
+	}}
+	configMap := unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]any{
+				"name":      "config",
+				"namespace": "foo",
+				"managedFields": []any{
+					map[string]any{"manager": "chainsaw"},
+				},
+			},
+			"data": map[string]any{
+				"key": "value",
+			},
+		},
+	}
+	clusterRole := unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]any{
+				"name": "role",
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		namespace    string
+		objects      []unstructured.Unstructured
+		discovery    *fakeDiscovery
+		getErr       error
+		expectedErr  string
+		expectedLogs []string
+		expectedList []string
+	}{{
+		name:      "namespace",
+		namespace: "foo",
+		discovery: &fakeDiscovery{resources: resources},
+		expectedLogs: []string{
+			"DUMP: RUN - []",
+			"DUMP: LOG - [=== RESOURCES\napiVersion: v1\ndata:\n  key: value\nkind: ConfigMap\nmetadata:\n  name: config\n  namespace: foo]",
+			"DUMP: DONE - []",
+		},
+		expectedList: []string{"/v1, Kind=ConfigMapList"},
+	}, {
+		name:      "cluster scoped objects",
+		namespace: "foo",
+		discovery: &fakeDiscovery{resources: resources},
+		objects:   []unstructured.Unstructured{clusterRole},
+		expectedLogs: []string{
+			"DUMP: RUN - []",
+			"DUMP: LOG - [=== RESOURCES\napiVersion: v1\ndata:\n  key: value\nkind: ConfigMap\nmetadata:\n  name: config\n  namespace: foo\n---\napiVersion: rbac.authorization.k8s.io/v1\nkind: ClusterRole\nmetadata:\n  name: role]",
+			"DUMP: DONE - []",
+		},
+		expectedList: []string{"/v1, Kind=ConfigMapList"},
+	}, {
+		name:      "deleted cluster scoped objects",
+		discovery: &fakeDiscovery{resources: resources},
+		objects:   []unstructured.Unstructured{clusterRole},
+		getErr:    kerrors.NewNotFound(schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "clusterroles"}, "role"),
+		expectedLogs: []string{
+			"DUMP: RUN - []",
+			"DUMP: DONE - []",
+		},
+	}, {
+		name:        "discovery error",
+		namespace:   "foo",
+		discovery:   &fakeDiscovery{err: errors.New("discovery failed")},
+		expectedErr: "discovery failed",
+		expectedLogs: []string{
+			"DUMP: RUN - []",
+			"DUMP: ERROR - [=== ERROR\ndiscovery failed]",
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var listed []string
+			client := &tclient.FakeClient{
+				GetFn: func(_ context.Context, _ int, key ctrlclient.ObjectKey, obj ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+					if tt.getErr != nil {
+						return tt.getErr
+					}
+					assert.Equal(t, clusterRole.GetName(), key.Name)
+					*obj.(*unstructured.Unstructured) = *clusterRole.DeepCopy()
+					return nil
+				},
+				ListFn: func(_ context.Context, _ int, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) error {
+					var options ctrlclient.ListOptions
+					options.ApplyOptions(opts)
+					assert.Equal(t, tt.namespace, options.Namespace)
+					listed = append(listed, list.GetObjectKind().GroupVersionKind().String())
+					list.(*unstructured.UnstructuredList).Items = []unstructured.Unstructured{*configMap.DeepCopy()}
+					return nil
+				},
+			}
+			operation := New(client, tt.discovery, tt.namespace, tt.objects...)
+			logger := &tlogging.FakeLogger{}
+			_, err := operation.Exec(ttesting.IntoContext(logging.IntoContext(context.TODO(), logger), t), nil)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedLogs, logger.Logs)
+			assert.Equal(t, tt.expectedList, listed)
+		})
+	}
+}
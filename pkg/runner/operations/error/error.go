@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/client"
 	"github.com/kyverno/chainsaw/pkg/runner/check"
@@ -11,41 +12,50 @@ import (
 	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
 	"github.com/kyverno/chainsaw/pkg/runner/operations"
 	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/chainsaw/pkg/runner/template"
 	"go.uber.org/multierr"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 type operation struct {
 	client     client.Client
 	expected   unstructured.Unstructured
 	namespacer namespacer.Namespacer
+	template   bool
+	polling    v1alpha1.Polling
 }
 
-func New(client client.Client, expected unstructured.Unstructured, namespacer namespacer.Namespacer) operations.Operation {
+func New(client client.Client, expected unstructured.Unstructured, namespacer namespacer.Namespacer, template bool, polling v1alpha1.Polling) operations.Operation {
 	return &operation{
 		client:     client,
 		expected:   expected,
 		namespacer: namespacer,
+		template:   template,
+		polling:    polling,
 	}
 }
 
-func (o *operation) Exec(ctx context.Context) (err error) {
+func (o *operation) Exec(ctx context.Context, bindings binding.Bindings) (_ operations.Outputs, err error) {
 	logger := internal.GetLogger(ctx, &o.expected)
 	defer func() {
 		internal.LogEnd(logger, logging.Error, err)
 	}()
+	if o.template {
+		if err := template.Resource(ctx, &o.expected, bindings); err != nil {
+			return nil, err
+		}
+	}
 	if err := internal.ApplyNamespacer(o.namespacer, &o.expected); err != nil {
-		return err
+		return nil, err
 	}
 	internal.LogStart(logger, logging.Error)
-	return o.execute(ctx)
+	return nil, o.execute(ctx, bindings)
 }
 
-func (o *operation) execute(ctx context.Context) error {
+func (o *operation) execute(ctx context.Context, bindings binding.Bindings) error {
 	var lastErrs []error
-	err := wait.PollUntilContextCancel(ctx, internal.PollInterval, false, func(ctx context.Context) (_ bool, err error) {
+	err := internal.WaitFor(ctx, &o.expected, o.client, o.polling, false, func(ctx context.Context) (_ bool, err error) {
 		var errs []error
 		defer func() {
 			// record last errors only if there was no real error
@@ -63,7 +73,7 @@ func (o *operation) execute(ctx context.Context) error {
 		} else {
 			for i := range candidates {
 				candidate := candidates[i]
-				_errs, err := check.Check(ctx, candidate.UnstructuredContent(), nil, &v1alpha1.Check{Value: o.expected.UnstructuredContent()})
+				_errs, err := check.Check(ctx, candidate.UnstructuredContent(), bindings, &v1alpha1.Check{Value: o.expected.UnstructuredContent()})
 				if err != nil {
 					return false, err
 				}
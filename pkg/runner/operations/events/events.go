@@ -0,0 +1,97 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/client"
+	"github.com/kyverno/chainsaw/pkg/runner/artifacts"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/kyverno/ext/output/color"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type operation struct {
+	client    client.Client
+	collector v1alpha1.Events
+	namespace string
+}
+
+func New(client client.Client, collector v1alpha1.Events, namespace string) operations.Operation {
+	return &operation{
+		client:    client,
+		collector: collector,
+		namespace: namespace,
+	}
+}
+
+func (o *operation) Exec(ctx context.Context, _ binding.Bindings) (_ operations.Outputs, err error) {
+	logger := internal.GetLogger(ctx, nil)
+	defer func() {
+		internal.LogEnd(logger, logging.Events, err)
+	}()
+	internal.LogStart(logger, logging.Events)
+	return nil, o.execute(ctx)
+}
+
+func (o *operation) execute(ctx context.Context) error {
+	options, err := o.listOptions()
+	if err != nil {
+		return err
+	}
+	var list corev1.EventList
+	if err := o.client.List(ctx, &list, options...); err != nil {
+		return err
+	}
+	if len(list.Items) != 0 {
+		content := internal.FormatEvents(list.Items)
+		if logger := logging.FromContext(ctx); logger != nil {
+			logger.Log(logging.Events, logging.LogStatus, color.BoldFgCyan, logging.Section("EVENTS", content))
+		}
+		return artifacts.Write(ctx, "events.log", []byte(content+"\n"))
+	}
+	return nil
+}
+
+func (o *operation) listOptions() ([]ctrlclient.ListOption, error) {
+	if o.collector.Name != "" && o.collector.Selector != "" {
+		return nil, errors.New("name cannot be provided when a selector is specified")
+	}
+	namespace := o.collector.Namespace
+	if namespace == "" {
+		namespace = o.namespace
+	}
+	options := []ctrlclient.ListOption{ctrlclient.InNamespace(namespace)}
+	if o.collector.Selector != "" {
+		selector, err := labels.Parse(o.collector.Selector)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, ctrlclient.MatchingLabelsSelector{Selector: selector})
+	}
+	fields := ctrlclient.MatchingFields{}
+	if o.collector.Name != "" {
+		fields["metadata.name"] = o.collector.Name
+	}
+	if o.collector.Type != "" {
+		fields["type"] = o.collector.Type
+	}
+	if o.collector.InvolvedObject != nil {
+		if o.collector.InvolvedObject.Kind != "" {
+			fields["involvedObject.kind"] = o.collector.InvolvedObject.Kind
+		}
+		if o.collector.InvolvedObject.Name != "" {
+			fields["involvedObject.name"] = o.collector.InvolvedObject.Name
+		}
+	}
+	if len(fields) != 0 {
+		options = append(options, fields)
+	}
+	return options, nil
+}
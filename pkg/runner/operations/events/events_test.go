@@ -0,0 +1,170 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	tclient "github.com/kyverno/chainsaw/pkg/client/testing"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	ttesting "github.com/kyverno/chainsaw/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_operation_Exec(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	events := []corev1.Event{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "event-2",
+			Namespace: "foo",
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind: "Pod",
+			Name: "pod-1",
+		},
+		Type:          corev1.EventTypeWarning,
+		Reason:        "BackOff",
+		Message:       "Back-off restarting failed container",
+		LastTimestamp: metav1.NewTime(now.Add(time.Minute)),
+	}, {
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "event-1",
+			Namespace: "foo",
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind: "Pod",
+			Name: "pod-1",
+		},
+		Type:          corev1.EventTypeNormal,
+		Reason:        "Scheduled",
+		Message:       "Successfully assigned foo/pod-1",
+		LastTimestamp: metav1.NewTime(now),
+	}}
+	tests := []struct {
+		name          string
+		collector     v1alpha1.Events
+		namespace     string
+		listErr       error
+		wantNamespace string
+		wantFields    string
+		wantLabels    string
+		expectedErr   string
+		expectedLogs  []string
+	}{{
+		name: "name and selector",
+		collector: v1alpha1.Events{
+			Name:     "foo",
+			Selector: "app=foo",
+		},
+		namespace:    "foo",
+		expectedErr:  "name cannot be provided when a selector is specified",
+		expectedLogs: []string{"EVENTS: RUN - []", "EVENTS: ERROR - [=== ERROR\nname cannot be provided when a selector is specified]"},
+	}, {
+		name:          "test namespace",
+		collector:     v1alpha1.Events{},
+		namespace:     "foo",
+		wantNamespace: "foo",
+		expectedLogs: []string{
+			"EVENTS: RUN - []",
+			"EVENTS: LOG - [=== EVENTS\n" +
+				"LAST SEEN              TYPE      REASON      OBJECT      MESSAGE\n" +
+				"2024-01-01T10:00:00Z   Normal    Scheduled   pod/pod-1   Successfully assigned foo/pod-1\n" +
+				"2024-01-01T10:01:00Z   Warning   BackOff     pod/pod-1   Back-off restarting failed container]",
+			"EVENTS: DONE - []",
+		},
+	}, {
+		name: "with filters",
+		collector: v1alpha1.Events{
+			Namespace: "bar",
+			Selector:  "app=foo",
+			Type:      corev1.EventTypeWarning,
+			InvolvedObject: &v1alpha1.InvolvedObject{
+				Kind: "Pod",
+				Name: "pod-1",
+			},
+		},
+		namespace:     "foo",
+		wantNamespace: "bar",
+		wantFields:    "type=Warning,involvedObject.kind=Pod,involvedObject.name=pod-1",
+		wantLabels:    "app=foo",
+		expectedLogs: []string{
+			"EVENTS: RUN - []",
+			"EVENTS: LOG - [=== EVENTS\n" +
+				"LAST SEEN              TYPE      REASON      OBJECT      MESSAGE\n" +
+				"2024-01-01T10:00:00Z   Normal    Scheduled   pod/pod-1   Successfully assigned foo/pod-1\n" +
+				"2024-01-01T10:01:00Z   Warning   BackOff     pod/pod-1   Back-off restarting failed container]",
+			"EVENTS: DONE - []",
+		},
+	}, {
+		name: "with name",
+		collector: v1alpha1.Events{
+			Name: "event-1",
+		},
+		namespace:     "foo",
+		wantNamespace: "foo",
+		wantFields:    "metadata.name=event-1",
+		expectedLogs: []string{
+			"EVENTS: RUN - []",
+			"EVENTS: LOG - [=== EVENTS\n" +
+				"LAST SEEN              TYPE      REASON      OBJECT      MESSAGE\n" +
+				"2024-01-01T10:00:00Z   Normal    Scheduled   pod/pod-1   Successfully assigned foo/pod-1\n" +
+				"2024-01-01T10:01:00Z   Warning   BackOff     pod/pod-1   Back-off restarting failed container]",
+			"EVENTS: DONE - []",
+		},
+	}, {
+		name:          "list error",
+		collector:     v1alpha1.Events{},
+		namespace:     "foo",
+		listErr:       errors.New("internal error"),
+		wantNamespace: "foo",
+		expectedErr:   "internal error",
+		expectedLogs:  []string{"EVENTS: RUN - []", "EVENTS: ERROR - [=== ERROR\ninternal error]"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &tclient.FakeClient{
+				ListFn: func(_ context.Context, _ int, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) error {
+					var options ctrlclient.ListOptions
+					options.ApplyOptions(opts)
+					assert.Equal(t, tt.wantNamespace, options.Namespace)
+					if tt.wantFields == "" {
+						assert.Nil(t, options.FieldSelector)
+					} else {
+						want := strings.Split(tt.wantFields, ",")
+						got := strings.Split(options.FieldSelector.String(), ",")
+						sort.Strings(want)
+						sort.Strings(got)
+						assert.Equal(t, want, got)
+					}
+					if tt.wantLabels == "" {
+						assert.Nil(t, options.LabelSelector)
+					} else {
+						assert.Equal(t, tt.wantLabels, options.LabelSelector.String())
+					}
+					if tt.listErr != nil {
+						return tt.listErr
+					}
+					list.(*corev1.EventList).Items = append([]corev1.Event{}, events...)
+					return nil
+				},
+			}
+			operation := New(client, tt.collector, tt.namespace)
+			logger := &tlogging.FakeLogger{}
+			_, err := operation.Exec(ttesting.IntoContext(logging.IntoContext(context.TODO(), logger), t), nil)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedLogs, logger.Logs)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	runnerbindings "github.com/kyverno/chainsaw/pkg/runner/bindings"
+	"github.com/kyverno/chainsaw/pkg/runner/check"
+	"github.com/kyverno/chainsaw/pkg/runner/env"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/kyverno/ext/output/color"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// defaultContainerAnnotation is the annotation kubectl uses to select the default container of a pod.
+const defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+// executor runs a command in a pod container and streams its outputs.
+type executor func(ctx context.Context, pod corev1.Pod, container string, command []string, stdout io.Writer, stderr io.Writer) error
+
+type operation struct {
+	client    kubernetes.Interface
+	executor  executor
+	exec      v1alpha1.Exec
+	namespace string
+}
+
+func New(client kubernetes.Interface, cfg *rest.Config, exec v1alpha1.Exec, namespace string) operations.Operation {
+	return &operation{
+		client:    client,
+		executor:  spdyExecutor(client, cfg),
+		exec:      exec,
+		namespace: namespace,
+	}
+}
+
+func (o *operation) Exec(ctx context.Context, bindings binding.Bindings) (_ operations.Outputs, err error) {
+	logger := internal.GetLogger(ctx, nil)
+	defer func() {
+		internal.LogEnd(logger, logging.Exec, err)
+	}()
+	namespace := o.exec.Namespace
+	if namespace == "" {
+		namespace = o.namespace
+	}
+	pod, err := o.pod(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	container, err := defaultContainer(*pod, o.exec.Container)
+	if err != nil {
+		return nil, err
+	}
+	command := append([]string{o.exec.Entrypoint}, env.Expand(map[string]string{"NAMESPACE": o.namespace}, o.exec.Args...)...)
+	internal.LogStart(
+		logger,
+		logging.Exec,
+		logging.Section("POD", fmt.Sprintf("%s/%s (%s)", pod.Namespace, pod.Name, container)),
+		logging.Section("COMMAND", strings.Join(command, " ")),
+	)
+	return o.execute(ctx, bindings, *pod, container, command)
+}
+
+func (o *operation) pod(ctx context.Context, namespace string) (*corev1.Pod, error) {
+	if o.exec.Name != "" && o.exec.Selector != "" {
+		return nil, errors.New("name cannot be provided when a selector is specified")
+	}
+	if o.exec.Name != "" {
+		return o.client.CoreV1().Pods(namespace).Get(ctx, o.exec.Name, metav1.GetOptions{})
+	}
+	if o.exec.Selector == "" {
+		return nil, errors.New("a pod name or selector must be specified")
+	}
+	list, err := o.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: o.exec.Selector})
+	if err != nil {
+		return nil, err
+	}
+	pods := list.Items
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+	for i := range pods {
+		if pods[i].Status.Phase == corev1.PodRunning {
+			return &pods[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no running pod found matching selector %s in namespace %s", o.exec.Selector, namespace)
+}
+
+func defaultContainer(pod corev1.Pod, container string) (string, error) {
+	if container == "" {
+		container = pod.Annotations[defaultContainerAnnotation]
+	}
+	if container == "" {
+		if len(pod.Spec.Containers) == 0 {
+			return "", fmt.Errorf("pod %s/%s has no container", pod.Namespace, pod.Name)
+		}
+		return pod.Spec.Containers[0].Name, nil
+	}
+	for _, c := range pod.Spec.Containers {
+		if c.Name == container {
+			return container, nil
+		}
+	}
+	return "", fmt.Errorf("container %s not found in pod %s/%s", container, pod.Namespace, pod.Name)
+}
+
+func (o *operation) execute(ctx context.Context, bindings binding.Bindings, pod corev1.Pod, container string, command []string) (operations.Outputs, error) {
+	logger := logging.FromContext(ctx)
+	var output internal.CommandOutput
+	if !o.exec.SkipLogOutput {
+		defer func() {
+			if sections := output.Sections(); len(sections) != 0 && logger != nil {
+				logger.Log(logging.Exec, logging.LogStatus, color.BoldFgCyan, sections...)
+			}
+		}()
+	}
+	err := o.executor(ctx, pod, container, command, &output.Stdout, &output.Stderr)
+	if !o.exec.SkipLogOutput {
+		if err := output.Save(ctx, "exec"); err != nil {
+			return nil, err
+		}
+	}
+	if bindings == nil {
+		bindings = binding.NewBindings()
+	}
+	if err == nil {
+		bindings = bindings.Register("$error", binding.NewBinding(nil))
+	} else {
+		bindings = bindings.Register("$error", binding.NewBinding(err.Error()))
+	}
+	bindings = bindings.Register("$stdout", binding.NewBinding(output.Out()))
+	bindings = bindings.Register("$stderr", binding.NewBinding(output.Err()))
+	if o.exec.Check == nil || o.exec.Check.Value == nil {
+		if err != nil {
+			return nil, err
+		}
+	} else if errs, err := check.Check(ctx, nil, bindings, o.exec.Check); err != nil {
+		return nil, err
+	} else if err := errs.ToAggregate(); err != nil {
+		return nil, err
+	}
+	return runnerbindings.ProcessOutputs(ctx, bindings, nil, o.exec.Outputs...)
+}
+
+// spdyExecutor streams the command outputs using the SPDY protocol.
+// The websocket protocol is not supported, it requires k8s.io/client-go v0.29 or later.
+func spdyExecutor(client kubernetes.Interface, cfg *rest.Config) executor {
+	return func(ctx context.Context, pod corev1.Pod, container string, command []string, stdout io.Writer, stderr io.Writer) error {
+		request := client.CoreV1().RESTClient().
+			Post().
+			Resource("pods").
+			Namespace(pod.Namespace).
+			Name(pod.Name).
+			SubResource("exec").
+			VersionedParams(&corev1.PodExecOptions{
+				Container: container,
+				Command:   command,
+				Stdout:    true,
+				Stderr:    true,
+			}, scheme.ParameterCodec)
+		executor, err := remotecommand.NewSPDYExecutor(cfg, "POST", request.URL())
+		if err != nil {
+			return err
+		}
+		return executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+			Stdout: stdout,
+			Stderr: stderr,
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+	ttesting "github.com/kyverno/chainsaw/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_operation_Exec(t *testing.T) {
+	pod := func(name string, labels map[string]string, annotations map[string]string, phase corev1.PodPhase, containers ...string) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "foo",
+				Labels:      labels,
+				Annotations: annotations,
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+			},
+		}
+		for _, container := range containers {
+			pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: container})
+		}
+		return pod
+	}
+	// echo writes the pod, container and command to stdout, or fails when the command is `false`
+	echo := func(_ context.Context, pod corev1.Pod, container string, command []string, stdout io.Writer, stderr io.Writer) error {
+		if command[0] == "false" {
+			fmt.Fprint(stderr, "failure")
+			return errors.New("command terminated with exit code 1")
+		}
+		fmt.Fprintf(stdout, "%s/%s %s", pod.Name, container, strings.Join(command, " "))
+		return nil
+	}
+	tests := []struct {
+		name         string
+		exec         v1alpha1.Exec
+		want         operations.Outputs
+		expectedErr  string
+		expectedLogs []string
+	}{{
+		name: "with name",
+		exec: v1alpha1.Exec{
+			Name:       "pod-1",
+			Entrypoint: "echo",
+			Args:       []string{"$NAMESPACE"},
+		},
+		expectedLogs: []string{
+			"EXEC: RUN - [=== POD\nfoo/pod-1 (a) === COMMAND\necho foo]",
+			"EXEC: LOG - [=== STDOUT\npod-1/a echo foo]",
+			"EXEC: DONE - []",
+		},
+	}, {
+		name: "with container",
+		exec: v1alpha1.Exec{
+			Name:       "pod-1",
+			Container:  "b",
+			Entrypoint: "hostname",
+		},
+		expectedLogs: []string{
+			"EXEC: RUN - [=== POD\nfoo/pod-1 (b) === COMMAND\nhostname]",
+			"EXEC: LOG - [=== STDOUT\npod-1/b hostname]",
+			"EXEC: DONE - []",
+		},
+	}, {
+		name: "with default container annotation",
+		exec: v1alpha1.Exec{
+			Name:       "pod-3",
+			Entrypoint: "hostname",
+		},
+		expectedLogs: []string{
+			"EXEC: RUN - [=== POD\nfoo/pod-3 (e) === COMMAND\nhostname]",
+			"EXEC: LOG - [=== STDOUT\npod-3/e hostname]",
+			"EXEC: DONE - []",
+		},
+	}, {
+		name: "with selector",
+		exec: v1alpha1.Exec{
+			Selector:      "app=foo",
+			Entrypoint:    "hostname",
+			SkipLogOutput: true,
+		},
+		expectedLogs: []string{
+			"EXEC: RUN - [=== POD\nfoo/pod-3 (e) === COMMAND\nhostname]",
+			"EXEC: DONE - []",
+		},
+	}, {
+		name: "no running pod",
+		exec: v1alpha1.Exec{
+			Selector:   "app=bar",
+			Entrypoint: "hostname",
+		},
+		expectedErr: "no running pod found matching selector app=bar in namespace foo",
+		expectedLogs: []string{
+			"EXEC: ERROR - [=== ERROR\nno running pod found matching selector app=bar in namespace foo]",
+		},
+	}, {
+		name: "unknown container",
+		exec: v1alpha1.Exec{
+			Name:       "pod-1",
+			Container:  "z",
+			Entrypoint: "hostname",
+		},
+		expectedErr: "container z not found in pod foo/pod-1",
+		expectedLogs: []string{
+			"EXEC: ERROR - [=== ERROR\ncontainer z not found in pod foo/pod-1]",
+		},
+	}, {
+		name: "pod not found",
+		exec: v1alpha1.Exec{
+			Name:       "pod-4",
+			Entrypoint: "hostname",
+		},
+		expectedErr: `pods "pod-4" not found`,
+		expectedLogs: []string{
+			"EXEC: ERROR - [=== ERROR\npods \"pod-4\" not found]",
+		},
+	}, {
+		name: "failure",
+		exec: v1alpha1.Exec{
+			Name:       "pod-1",
+			Entrypoint: "false",
+		},
+		expectedErr: "command terminated with exit code 1",
+		expectedLogs: []string{
+			"EXEC: RUN - [=== POD\nfoo/pod-1 (a) === COMMAND\nfalse]",
+			"EXEC: LOG - [=== STDERR\nfailure]",
+			"EXEC: ERROR - [=== ERROR\ncommand terminated with exit code 1]",
+		},
+	}, {
+		name: "with check",
+		exec: v1alpha1.Exec{
+			Name:          "pod-1",
+			Entrypoint:    "false",
+			SkipLogOutput: true,
+			Check: &v1alpha1.Check{
+				Value: map[string]any{
+					"($error != null)": true,
+					"($stderr)":        "failure",
+				},
+			},
+		},
+		expectedLogs: []string{
+			"EXEC: RUN - [=== POD\nfoo/pod-1 (a) === COMMAND\nfalse]",
+			"EXEC: DONE - []",
+		},
+	}, {
+		name: "with failed check",
+		exec: v1alpha1.Exec{
+			Name:          "pod-1",
+			Entrypoint:    "hostname",
+			SkipLogOutput: true,
+			Check: &v1alpha1.Check{
+				Value: map[string]any{
+					"($stdout)": "pod-2/a hostname",
+				},
+			},
+		},
+		expectedErr: "($stdout): Invalid value: \"pod-1/a hostname\": Expected value: \"pod-2/a hostname\"",
+		expectedLogs: []string{
+			"EXEC: RUN - [=== POD\nfoo/pod-1 (a) === COMMAND\nhostname]",
+			"EXEC: ERROR - [=== ERROR\n($stdout): Invalid value: \"pod-1/a hostname\": Expected value: \"pod-2/a hostname\"]",
+		},
+	}, {
+		name: "with outputs",
+		exec: v1alpha1.Exec{
+			Name:          "pod-1",
+			Entrypoint:    "hostname",
+			SkipLogOutput: true,
+			Outputs: []v1alpha1.Output{{
+				Binding: v1alpha1.Binding{
+					Name:  "hostname",
+					Value: v1alpha1.Check{Value: "($stdout)"},
+				},
+			}},
+		},
+		want: map[string]any{
+			"hostname": "pod-1/a hostname",
+		},
+		expectedLogs: []string{
+			"EXEC: RUN - [=== POD\nfoo/pod-1 (a) === COMMAND\nhostname]",
+			"EXEC: DONE - []",
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(
+				pod("pod-1", nil, nil, corev1.PodRunning, "a", "b"),
+				pod("pod-2", map[string]string{"app": "foo"}, nil, corev1.PodPending, "c"),
+				pod("pod-3", map[string]string{"app": "foo"}, map[string]string{defaultContainerAnnotation: "e"}, corev1.PodRunning, "d", "e"),
+			)
+			operation := &operation{
+				client:    client,
+				executor:  echo,
+				exec:      tt.exec,
+				namespace: "foo",
+			}
+			logger := &tlogging.FakeLogger{}
+			outputs, err := operation.Exec(ttesting.IntoContext(logging.IntoContext(context.TODO(), logger), t), nil)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, outputs)
+			assert.Equal(t, tt.expectedLogs, logger.Logs)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package get
+
+import (
+	"context"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/client"
+	"github.com/kyverno/chainsaw/pkg/runner/artifacts"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/kyverno/ext/output/color"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type operation struct {
+	client     client.Client
+	obj        unstructured.Unstructured
+	namespacer namespacer.Namespacer
+}
+
+// New returns an operation logging the objects matching obj as YAML.
+func New(client client.Client, obj unstructured.Unstructured, namespacer namespacer.Namespacer) operations.Operation {
+	return &operation{
+		client:     client,
+		obj:        obj,
+		namespacer: namespacer,
+	}
+}
+
+func (o *operation) Exec(ctx context.Context, _ binding.Bindings) (_ operations.Outputs, err error) {
+	logger := internal.GetLogger(ctx, &o.obj)
+	defer func() {
+		internal.LogEnd(logger, logging.Get, err)
+	}()
+	if err := internal.ApplyNamespacer(o.namespacer, &o.obj); err != nil {
+		return nil, err
+	}
+	internal.LogStart(logger, logging.Get)
+	return nil, o.execute(ctx)
+}
+
+func (o *operation) execute(ctx context.Context) error {
+	resources, err := internal.Read(ctx, &o.obj, o.client)
+	if err != nil {
+		return err
+	}
+	if len(resources) != 0 {
+		content, err := internal.FormatResources(resources)
+		if err != nil {
+			return err
+		}
+		if logger := logging.FromContext(ctx); logger != nil {
+			logger.Log(logging.Get, logging.LogStatus, color.BoldFgCyan, logging.Section("RESOURCES", content))
+		}
+		return artifacts.Write(ctx, "get.yaml", []byte(content+"\n"))
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package get
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tclient "github.com/kyverno/chainsaw/pkg/client/testing"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	ttesting "github.com/kyverno/chainsaw/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_operation_Exec(t *testing.T) {
+	configMap := func(name string) unstructured.Unstructured {
+		return unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]any{
+					"name":      name,
+					"namespace": "foo",
+					"labels": map[string]any{
+						"app": "foo",
+					},
+					"managedFields": []any{
+						map[string]any{"manager": "chainsaw"},
+					},
+				},
+				"data": map[string]any{
+					"key": "value",
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name         string
+		items        []unstructured.Unstructured
+		listErr      error
+		expectedErr  string
+		expectedLogs []string
+	}{{
+		name:  "with objects",
+		items: []unstructured.Unstructured{configMap("cm-1"), configMap("cm-2")},
+		expectedLogs: []string{
+			"GET: RUN - []",
+			"GET: LOG - [=== RESOURCES\n" +
+				"apiVersion: v1\ndata:\n  key: value\nkind: ConfigMap\nmetadata:\n  labels:\n    app: foo\n  name: cm-1\n  namespace: foo\n" +
+				"---\n" +
+				"apiVersion: v1\ndata:\n  key: value\nkind: ConfigMap\nmetadata:\n  labels:\n    app: foo\n  name: cm-2\n  namespace: foo]",
+			"GET: DONE - []",
+		},
+	}, {
+		name: "without objects",
+		expectedLogs: []string{
+			"GET: RUN - []",
+			"GET: DONE - []",
+		},
+	}, {
+		name:        "list error",
+		listErr:     errors.New("internal error"),
+		expectedErr: "internal error",
+		expectedLogs: []string{
+			"GET: RUN - []",
+			"GET: ERROR - [=== ERROR\ninternal error]",
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &tclient.FakeClient{
+				ListFn: func(_ context.Context, _ int, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) error {
+					var options ctrlclient.ListOptions
+					options.ApplyOptions(opts)
+					assert.Equal(t, "foo", options.Namespace)
+					assert.Equal(t, "app=foo", options.LabelSelector.String())
+					if tt.listErr != nil {
+						return tt.listErr
+					}
+					list.(*unstructured.UnstructuredList).Items = tt.items
+					return nil
+				},
+			}
+			var obj unstructured.Unstructured
+			obj.SetAPIVersion("v1")
+			obj.SetKind("ConfigMap")
+			obj.SetNamespace("foo")
+			obj.SetLabels(map[string]string{"app": "foo"})
+			operation := New(client, obj, nil)
+			logger := &tlogging.FakeLogger{}
+			_, err := operation.Exec(ttesting.IntoContext(logging.IntoContext(context.TODO(), logger), t), nil)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedLogs, logger.Logs)
+		})
+	}
+}
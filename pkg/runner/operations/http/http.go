@@ -0,0 +1,469 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	runnerbindings "github.com/kyverno/chainsaw/pkg/runner/bindings"
+	"github.com/kyverno/chainsaw/pkg/runner/check"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/kyverno/ext/output/color"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// endpoint is where requests are sent, close releases the resources associated with it.
+type endpoint struct {
+	client *http.Client
+	url    string
+	close  func()
+}
+
+type response struct {
+	status  int
+	headers map[string]any
+	body    any
+}
+
+// forwarder forwards a local port to the pod port, it returns the local port and a function to stop forwarding.
+type forwarder = func(ctx context.Context, pod corev1.Pod, port int32) (uint16, func(), error)
+
+type operation struct {
+	client    kubernetes.Interface
+	cfg       *rest.Config
+	forwarder forwarder
+	request   v1alpha1.HTTP
+	basePath  string
+	namespace string
+	polling   v1alpha1.Polling
+}
+
+func New(client kubernetes.Interface, cfg *rest.Config, request v1alpha1.HTTP, basePath string, namespace string, polling v1alpha1.Polling) operations.Operation {
+	operation := &operation{
+		client:    client,
+		cfg:       cfg,
+		request:   request,
+		basePath:  basePath,
+		namespace: namespace,
+		polling:   polling,
+	}
+	operation.forwarder = operation.portForward
+	return operation
+}
+
+func (o *operation) Exec(ctx context.Context, bindings binding.Bindings) (_ operations.Outputs, err error) {
+	logger := internal.GetLogger(ctx, nil)
+	defer func() {
+		internal.LogEnd(logger, logging.HTTP, err)
+	}()
+	sections := []fmt.Stringer{logging.Section("REQUEST", fmt.Sprintf("%s %s", o.method(), o.request.URL))}
+	if target := o.request.Target; target != nil {
+		mode := "port-forward"
+		if target.Proxy {
+			mode = "proxy"
+		}
+		sections = append(sections, logging.Section("TARGET", fmt.Sprintf("%s/%s/%s:%d (%s)", strings.ToLower(target.Kind), o.targetNamespace(), target.Name, target.Port, mode)))
+	}
+	internal.LogStart(logger, logging.HTTP, sections...)
+	return o.execute(ctx, bindings)
+}
+
+func (o *operation) execute(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error) {
+	if bindings == nil {
+		bindings = binding.NewBindings()
+	}
+	var outputs operations.Outputs
+	var lastErr error
+	var last *response
+	// the endpoint is resolved lazily and reset when a request fails, the target may not be ready yet
+	// or the port-forward may have been interrupted
+	var current *endpoint
+	defer func() {
+		if current != nil {
+			current.close()
+		}
+		if last != nil {
+			if logger := logging.FromContext(ctx); logger != nil {
+				logger.Log(logging.HTTP, logging.LogStatus, color.BoldFgCyan, logging.Section("RESPONSE", last.String()))
+			}
+		}
+	}()
+	err := internal.Poll(ctx, o.polling, true, func(ctx context.Context) (_ bool, err error) {
+		var response *response
+		if current == nil {
+			current, err = o.endpoint(ctx)
+		}
+		if err == nil {
+			response, err = o.send(ctx, current)
+			if err != nil {
+				current.close()
+				current = nil
+			}
+		}
+		// the attempt was interrupted, keep the outcome of the previous attempt
+		if err != nil && ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		bindings := bindings
+		if err == nil {
+			last = response
+			bindings = bindings.Register("$error", binding.NewBinding(nil))
+			bindings = bindings.Register("$status", binding.NewBinding(response.status))
+			bindings = bindings.Register("$headers", binding.NewBinding(response.headers))
+			bindings = bindings.Register("$body", binding.NewBinding(response.body))
+		} else {
+			bindings = bindings.Register("$error", binding.NewBinding(err.Error()))
+			bindings = bindings.Register("$status", binding.NewBinding(nil))
+			bindings = bindings.Register("$headers", binding.NewBinding(nil))
+			bindings = bindings.Register("$body", binding.NewBinding(nil))
+		}
+		if o.request.Check == nil || o.request.Check.Value == nil {
+			if err != nil {
+				lastErr = err
+				return false, nil
+			}
+			if response.status >= http.StatusBadRequest {
+				lastErr = fmt.Errorf("unexpected status code %d", response.status)
+				return false, nil
+			}
+		} else if errs, err := check.Check(ctx, nil, bindings, o.request.Check); err != nil {
+			return false, err
+		} else if err := errs.ToAggregate(); err != nil {
+			lastErr = err
+			return false, nil
+		}
+		outputs, err = runnerbindings.ProcessOutputs(ctx, bindings, nil, o.request.Outputs...)
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+	// if no error, return success
+	if err == nil {
+		return outputs, nil
+	}
+	// eventually return the last error
+	if lastErr != nil && ctx.Err() != nil {
+		return nil, lastErr
+	}
+	// return received error
+	return nil, err
+}
+
+func (o *operation) send(ctx context.Context, endpoint *endpoint) (*response, error) {
+	body, isJSON, err := o.body()
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, o.method(), endpoint.url, body)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range o.request.Headers {
+		request.Header.Set(key, value)
+	}
+	if isJSON && request.Header.Get("Content-Type") == "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := endpoint.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	headers := map[string]any{}
+	for key, values := range resp.Header {
+		headers[key] = strings.Join(values, ", ")
+	}
+	var parsed any
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		parsed = string(data)
+	}
+	return &response{
+		status:  resp.StatusCode,
+		headers: headers,
+		body:    parsed,
+	}, nil
+}
+
+func (o *operation) method() string {
+	if o.request.Method == "" {
+		return http.MethodGet
+	}
+	return o.request.Method
+}
+
+func (o *operation) body() (io.Reader, bool, error) {
+	if o.request.Body == nil || o.request.Body.Value == nil {
+		return nil, false, nil
+	}
+	if body, ok := o.request.Body.Value.(string); ok {
+		return strings.NewReader(body), false, nil
+	}
+	data, err := json.Marshal(o.request.Body.Value)
+	if err != nil {
+		return nil, false, err
+	}
+	return bytes.NewReader(data), true, nil
+}
+
+func (o *operation) targetNamespace() string {
+	if o.request.Target != nil && o.request.Target.Namespace != "" {
+		return o.request.Target.Namespace
+	}
+	return o.namespace
+}
+
+func (o *operation) endpoint(ctx context.Context) (*endpoint, error) {
+	target, err := url.Parse(o.request.URL)
+	if err != nil {
+		return nil, err
+	}
+	if o.request.Target == nil {
+		client, err := o.httpClient()
+		if err != nil {
+			return nil, err
+		}
+		return &endpoint{client: client, url: target.String(), close: func() {}}, nil
+	}
+	if o.request.Target.Proxy {
+		if o.cfg == nil {
+			return nil, errors.New("http requests can't target services or pods without a rest config")
+		}
+		client, err := rest.HTTPClientFor(o.cfg)
+		if err != nil {
+			return nil, err
+		}
+		u, err := proxyURL(o.cfg.Host, *o.request.Target, o.targetNamespace(), target)
+		if err != nil {
+			return nil, err
+		}
+		return &endpoint{client: client, url: u, close: func() {}}, nil
+	}
+	pod, port, err := o.forwardedPod(ctx)
+	if err != nil {
+		return nil, err
+	}
+	localPort, stop, err := o.forwarder(ctx, *pod, port)
+	if err != nil {
+		return nil, err
+	}
+	client, err := o.httpClient()
+	if err != nil {
+		stop()
+		return nil, err
+	}
+	u := *target
+	u.Scheme = scheme(*o.request.Target)
+	u.Host = fmt.Sprintf("127.0.0.1:%d", localPort)
+	return &endpoint{client: client, url: u.String(), close: stop}, nil
+}
+
+func (o *operation) httpClient() (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if o.request.TLS != nil {
+		config := &tls.Config{
+			InsecureSkipVerify: o.request.TLS.InsecureSkipVerify, //nolint:gosec
+			ServerName:         o.request.TLS.ServerName,
+		}
+		if o.request.TLS.CA != "" {
+			ca := o.request.TLS.CA
+			if !filepath.IsAbs(ca) {
+				ca = filepath.Join(o.basePath, ca)
+			}
+			data, err := os.ReadFile(ca)
+			if err != nil {
+				return nil, err
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(data) {
+				return nil, fmt.Errorf("failed to load CA bundle %s", o.request.TLS.CA)
+			}
+			config.RootCAs = pool
+		}
+		transport.TLSClientConfig = config
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+// forwardedPod returns the pod and the container port requests should be forwarded to.
+func (o *operation) forwardedPod(ctx context.Context) (*corev1.Pod, int32, error) {
+	target := o.request.Target
+	namespace := o.targetNamespace()
+	if target.Kind == "Pod" {
+		pod, err := o.client.CoreV1().Pods(namespace).Get(ctx, target.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, 0, err
+		}
+		if pod.Status.Phase != corev1.PodRunning {
+			return nil, 0, fmt.Errorf("pod %s/%s is not running", namespace, target.Name)
+		}
+		return pod, target.Port, nil
+	}
+	service, err := o.client.CoreV1().Services(namespace).Get(ctx, target.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, 0, err
+	}
+	var servicePort *corev1.ServicePort
+	for i := range service.Spec.Ports {
+		if service.Spec.Ports[i].Port == target.Port {
+			servicePort = &service.Spec.Ports[i]
+		}
+	}
+	if servicePort == nil {
+		return nil, 0, fmt.Errorf("port %d not found in service %s/%s", target.Port, namespace, target.Name)
+	}
+	if len(service.Spec.Selector) == 0 {
+		return nil, 0, fmt.Errorf("service %s/%s has no selector", namespace, target.Name)
+	}
+	list, err := o.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: labels.SelectorFromSet(service.Spec.Selector).String()})
+	if err != nil {
+		return nil, 0, err
+	}
+	pods := list.Items
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+	for i := range pods {
+		if pods[i].Status.Phase == corev1.PodRunning {
+			port, err := containerPort(pods[i], *servicePort)
+			if err != nil {
+				return nil, 0, err
+			}
+			return &pods[i], port, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("no running pod found for service %s/%s", namespace, target.Name)
+}
+
+func containerPort(pod corev1.Pod, servicePort corev1.ServicePort) (int32, error) {
+	if servicePort.TargetPort.Type == intstr.String {
+		for _, container := range pod.Spec.Containers {
+			for _, port := range container.Ports {
+				if port.Name == servicePort.TargetPort.StrVal {
+					return port.ContainerPort, nil
+				}
+			}
+		}
+		return 0, fmt.Errorf("port %s not found in pod %s/%s", servicePort.TargetPort.StrVal, pod.Namespace, pod.Name)
+	}
+	if servicePort.TargetPort.IntVal != 0 {
+		return servicePort.TargetPort.IntVal, nil
+	}
+	return servicePort.Port, nil
+}
+
+// portForward forwards a random local port to the pod port using the SPDY port-forward API.
+func (o *operation) portForward(ctx context.Context, pod corev1.Pod, port int32) (uint16, func(), error) {
+	if o.cfg == nil {
+		return 0, nil, errors.New("http requests can't target services or pods without a rest config")
+	}
+	transport, upgrader, err := spdy.RoundTripperFor(o.cfg)
+	if err != nil {
+		return 0, nil, err
+	}
+	request := o.client.CoreV1().RESTClient().
+		Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("portforward")
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, request.URL())
+	stopCh := make(chan struct{})
+	readyCh := make(chan struct{})
+	forwarder, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, []string{fmt.Sprintf("0:%d", port)}, stopCh, readyCh, io.Discard, io.Discard)
+	if err != nil {
+		return 0, nil, err
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- forwarder.ForwardPorts()
+	}()
+	stop := func() {
+		close(stopCh)
+	}
+	select {
+	case <-readyCh:
+	case err := <-errCh:
+		return 0, nil, fmt.Errorf("failed to forward port %d of pod %s/%s (%w)", port, pod.Namespace, pod.Name, err)
+	case <-ctx.Done():
+		stop()
+		return 0, nil, ctx.Err()
+	}
+	ports, err := forwarder.GetPorts()
+	if err != nil {
+		stop()
+		return 0, nil, err
+	}
+	return ports[0].Local, stop, nil
+}
+
+func scheme(target v1alpha1.HTTPTarget) string {
+	if target.Scheme == "" {
+		return "http"
+	}
+	return target.Scheme
+}
+
+// proxyURL returns the API server proxy url corresponding to the target.
+func proxyURL(host string, target v1alpha1.HTTPTarget, namespace string, u *url.URL) (string, error) {
+	base, err := url.Parse(host)
+	if err != nil {
+		return "", err
+	}
+	resource := "services"
+	if target.Kind == "Pod" {
+		resource = "pods"
+	}
+	base.Path = path.Join(
+		"/",
+		base.Path,
+		"api/v1/namespaces",
+		namespace,
+		resource,
+		fmt.Sprintf("%s:%s:%d", scheme(target), target.Name, target.Port),
+		"proxy",
+	) + "/" + strings.TrimPrefix(u.Path, "/")
+	base.RawQuery = u.RawQuery
+	return base.String(), nil
+}
+
+func (r *response) String() string {
+	status := fmt.Sprintf("%d %s", r.status, http.StatusText(r.status))
+	var body string
+	switch typed := r.body.(type) {
+	case string:
+		body = typed
+	default:
+		if data, err := json.Marshal(typed); err == nil {
+			body = string(data)
+		}
+	}
+	return strings.TrimSpace(status + "\n" + body)
+}
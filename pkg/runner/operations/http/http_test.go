@@ -0,0 +1,456 @@
+package http
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+	ttesting "github.com/kyverno/chainsaw/pkg/testing"
+	kjson "github.com/kyverno/kyverno-json/pkg/apis/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func Test_operation_Exec(t *testing.T) {
+	var calls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Header", r.Header.Get("X-Foo"))
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	})
+	mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+		_, _ = w.Write(data)
+	})
+	mux.HandleFunc("/flaky", func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ready"))
+	})
+	mux.HandleFunc("/error", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	interval := &metav1.Duration{Duration: 10 * time.Millisecond}
+	tests := []struct {
+		name         string
+		request      v1alpha1.HTTP
+		timeout      time.Duration
+		want         operations.Outputs
+		expectedErr  string
+		expectedLogs []string
+	}{{
+		name: "get",
+		request: v1alpha1.HTTP{
+			URL: server.URL + "/ok",
+		},
+		expectedLogs: []string{
+			"HTTP: RUN - [=== REQUEST\nGET " + server.URL + "/ok]",
+			"HTTP: LOG - [=== RESPONSE\n200 OK\n{\"status\":\"ok\"}]",
+			"HTTP: DONE - []",
+		},
+	}, {
+		name: "with check",
+		request: v1alpha1.HTTP{
+			URL:     server.URL + "/ok",
+			Headers: map[string]string{"X-Foo": "bar"},
+			Check: &v1alpha1.Check{
+				Value: map[string]any{
+					"($status)":                       200,
+					"($headers.\"X-Request-Header\")": "bar",
+					"($body.status)":                  "ok",
+				},
+			},
+		},
+		expectedLogs: []string{
+			"HTTP: RUN - [=== REQUEST\nGET " + server.URL + "/ok]",
+			"HTTP: LOG - [=== RESPONSE\n200 OK\n{\"status\":\"ok\"}]",
+			"HTTP: DONE - []",
+		},
+	}, {
+		name: "with json body",
+		request: v1alpha1.HTTP{
+			URL:    server.URL + "/echo",
+			Method: http.MethodPost,
+			Body:   &kjson.Any{Value: map[string]any{"foo": "bar"}},
+			Outputs: []v1alpha1.Output{{
+				Binding: v1alpha1.Binding{
+					Name:  "foo",
+					Value: v1alpha1.Check{Value: "($body.foo)"},
+				},
+			}, {
+				Binding: v1alpha1.Binding{
+					Name:  "contentType",
+					Value: v1alpha1.Check{Value: "($headers.\"Content-Type\")"},
+				},
+			}},
+		},
+		want: map[string]any{
+			"foo":         "bar",
+			"contentType": "application/json",
+		},
+		expectedLogs: []string{
+			"HTTP: RUN - [=== REQUEST\nPOST " + server.URL + "/echo]",
+			"HTTP: LOG - [=== RESPONSE\n200 OK\n{\"foo\":\"bar\"}]",
+			"HTTP: DONE - []",
+		},
+	}, {
+		name: "with string body",
+		request: v1alpha1.HTTP{
+			URL:    server.URL + "/echo",
+			Method: http.MethodPut,
+			Body:   &kjson.Any{Value: "hello"},
+			Check: &v1alpha1.Check{
+				Value: map[string]any{
+					"($body)": "hello",
+				},
+			},
+		},
+		expectedLogs: []string{
+			"HTTP: RUN - [=== REQUEST\nPUT " + server.URL + "/echo]",
+			"HTTP: LOG - [=== RESPONSE\n200 OK\nhello]",
+			"HTTP: DONE - []",
+		},
+	}, {
+		name: "retry",
+		request: v1alpha1.HTTP{
+			URL:     server.URL + "/flaky",
+			Polling: &v1alpha1.Polling{Interval: interval},
+		},
+		expectedLogs: []string{
+			"HTTP: RUN - [=== REQUEST\nGET " + server.URL + "/flaky]",
+			"HTTP: LOG - [=== RESPONSE\n200 OK\nready]",
+			"HTTP: DONE - []",
+		},
+	}, {
+		name: "error status",
+		request: v1alpha1.HTTP{
+			URL:     server.URL + "/error",
+			Polling: &v1alpha1.Polling{Interval: interval},
+		},
+		timeout:     100 * time.Millisecond,
+		expectedErr: "unexpected status code 500",
+		expectedLogs: []string{
+			"HTTP: RUN - [=== REQUEST\nGET " + server.URL + "/error]",
+			"HTTP: LOG - [=== RESPONSE\n500 Internal Server Error\nboom]",
+			"HTTP: ERROR - [=== ERROR\nunexpected status code 500]",
+		},
+	}, {
+		name: "with error check",
+		request: v1alpha1.HTTP{
+			URL: server.URL + "/error",
+			Check: &v1alpha1.Check{
+				Value: map[string]any{
+					"($status)": 500,
+				},
+			},
+		},
+		expectedLogs: []string{
+			"HTTP: RUN - [=== REQUEST\nGET " + server.URL + "/error]",
+			"HTTP: LOG - [=== RESPONSE\n500 Internal Server Error\nboom]",
+			"HTTP: DONE - []",
+		},
+	}, {
+		name: "with failed check",
+		request: v1alpha1.HTTP{
+			URL:     server.URL + "/ok",
+			Polling: &v1alpha1.Polling{Interval: interval},
+			Check: &v1alpha1.Check{
+				Value: map[string]any{
+					"($body.status)": "ko",
+				},
+			},
+		},
+		timeout:     100 * time.Millisecond,
+		expectedErr: "($body.status): Invalid value: \"ok\": Expected value: \"ko\"",
+		expectedLogs: []string{
+			"HTTP: RUN - [=== REQUEST\nGET " + server.URL + "/ok]",
+			"HTTP: LOG - [=== RESPONSE\n200 OK\n{\"status\":\"ok\"}]",
+			"HTTP: ERROR - [=== ERROR\n($body.status): Invalid value: \"ok\": Expected value: \"ko\"]",
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := New(nil, nil, tt.request, "", "foo", v1alpha1.Polling{})
+			logger := &tlogging.FakeLogger{}
+			ctx := ttesting.IntoContext(logging.IntoContext(context.TODO(), logger), t)
+			if tt.timeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+			outputs, err := operation.Exec(ctx, nil)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, outputs)
+			assert.Equal(t, tt.expectedLogs, logger.Logs)
+		})
+	}
+}
+
+func Test_operation_Exec_portForward(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	assert.NoError(t, err)
+	serverPort, err := strconv.Atoi(serverURL.Port())
+	assert.NoError(t, err)
+	// a port nothing listens on, requests sent to it fail like with an interrupted port-forward
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	deadPort := listener.Addr().(*net.TCPAddr).Port
+	assert.NoError(t, listener.Close())
+	tests := []struct {
+		name          string
+		pendingCalls  int
+		deadForwards  int
+		expectedOpens int
+	}{{
+		name:          "pod becomes ready",
+		pendingCalls:  2,
+		expectedOpens: 1,
+	}, {
+		name:          "forward interrupted",
+		deadForwards:  1,
+		expectedOpens: 2,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			var gets int
+			client.PrependReactor("get", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+				gets++
+				phase := corev1.PodRunning
+				if gets <= tt.pendingCalls {
+					phase = corev1.PodPending
+				}
+				return true, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "foo"},
+					Status:     corev1.PodStatus{Phase: phase},
+				}, nil
+			})
+			var opens, closes int
+			operation := New(client, nil, v1alpha1.HTTP{
+				URL:     "/",
+				Target:  &v1alpha1.HTTPTarget{Kind: "Pod", Name: "pod-1", Port: 80},
+				Polling: &v1alpha1.Polling{Interval: &metav1.Duration{Duration: 10 * time.Millisecond}},
+			}, "", "foo", v1alpha1.Polling{}).(*operation)
+			operation.forwarder = func(_ context.Context, pod corev1.Pod, port int32) (uint16, func(), error) {
+				assert.Equal(t, "pod-1", pod.Name)
+				assert.Equal(t, int32(80), port)
+				opens++
+				local := serverPort
+				if opens <= tt.deadForwards {
+					local = deadPort
+				}
+				return uint16(local), func() { closes++ }, nil
+			}
+			ctx, cancel := context.WithTimeout(ttesting.IntoContext(context.TODO(), t), 5*time.Second)
+			defer cancel()
+			_, err := operation.Exec(ctx, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.pendingCalls+tt.expectedOpens, gets)
+			assert.Equal(t, tt.expectedOpens, opens)
+			assert.Equal(t, opens, closes)
+		})
+	}
+}
+
+func Test_operation_TLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("secure"))
+	}))
+	defer server.Close()
+	basePath := t.TempDir()
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	assert.NoError(t, os.WriteFile(filepath.Join(basePath, "ca.crt"), ca, 0o600))
+	tests := []struct {
+		name        string
+		tls         *v1alpha1.HTTPTLS
+		expectedErr bool
+	}{{
+		name:        "untrusted",
+		expectedErr: true,
+	}, {
+		name: "insecure",
+		tls:  &v1alpha1.HTTPTLS{InsecureSkipVerify: true},
+	}, {
+		name: "ca",
+		tls:  &v1alpha1.HTTPTLS{CA: "ca.crt", ServerName: "example.com"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := New(nil, nil, v1alpha1.HTTP{URL: server.URL, TLS: tt.tls}, basePath, "foo", v1alpha1.Polling{})
+			ctx, cancel := context.WithTimeout(ttesting.IntoContext(context.TODO(), t), 100*time.Millisecond)
+			defer cancel()
+			_, err := operation.Exec(ctx, nil)
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_proxyURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		host   string
+		target v1alpha1.HTTPTarget
+		url    string
+		want   string
+	}{{
+		name:   "service",
+		host:   "https://127.0.0.1:6443",
+		target: v1alpha1.HTTPTarget{Kind: "Service", Name: "foo", Port: 80},
+		url:    "/healthz?verbose=true",
+		want:   "https://127.0.0.1:6443/api/v1/namespaces/bar/services/http:foo:80/proxy/healthz?verbose=true",
+	}, {
+		name:   "pod",
+		host:   "https://example.com/cluster/",
+		target: v1alpha1.HTTPTarget{Kind: "Pod", Name: "foo", Port: 8443, Scheme: "https"},
+		url:    "metrics",
+		want:   "https://example.com/cluster/api/v1/namespaces/bar/pods/https:foo:8443/proxy/metrics",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.url)
+			assert.NoError(t, err)
+			got, err := proxyURL(tt.host, tt.target, "bar", u)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_operation_forwardedPod(t *testing.T) {
+	pod := func(name string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "foo",
+				Labels:    map[string]string{"app": "foo"},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name:  "main",
+					Ports: []corev1.ContainerPort{{Name: "web", ContainerPort: 8080}},
+				}},
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+			},
+		}
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "svc",
+			Namespace: "foo",
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "foo"},
+			Ports: []corev1.ServicePort{
+				{Port: 80, TargetPort: intstr.FromString("web")},
+				{Port: 81, TargetPort: intstr.FromInt32(9090)},
+				{Port: 82},
+				{Port: 83, TargetPort: intstr.FromString("unknown")},
+			},
+		},
+	}
+	tests := []struct {
+		name        string
+		target      v1alpha1.HTTPTarget
+		wantPod     string
+		wantPort    int32
+		expectedErr string
+	}{{
+		name:     "pod",
+		target:   v1alpha1.HTTPTarget{Kind: "Pod", Name: "pod-2", Port: 1234},
+		wantPod:  "pod-2",
+		wantPort: 1234,
+	}, {
+		name:        "pod not running",
+		target:      v1alpha1.HTTPTarget{Kind: "Pod", Name: "pod-1", Port: 1234},
+		expectedErr: "pod foo/pod-1 is not running",
+	}, {
+		name:     "named target port",
+		target:   v1alpha1.HTTPTarget{Kind: "Service", Name: "svc", Port: 80},
+		wantPod:  "pod-2",
+		wantPort: 8080,
+	}, {
+		name:     "numbered target port",
+		target:   v1alpha1.HTTPTarget{Kind: "Service", Name: "svc", Port: 81},
+		wantPod:  "pod-2",
+		wantPort: 9090,
+	}, {
+		name:     "no target port",
+		target:   v1alpha1.HTTPTarget{Kind: "Service", Name: "svc", Port: 82},
+		wantPod:  "pod-2",
+		wantPort: 82,
+	}, {
+		name:        "unknown target port",
+		target:      v1alpha1.HTTPTarget{Kind: "Service", Name: "svc", Port: 83},
+		expectedErr: "port unknown not found in pod foo/pod-2",
+	}, {
+		name:        "unknown service port",
+		target:      v1alpha1.HTTPTarget{Kind: "Service", Name: "svc", Port: 84},
+		expectedErr: "port 84 not found in service foo/svc",
+	}, {
+		name:        "service not found",
+		target:      v1alpha1.HTTPTarget{Kind: "Service", Name: "unknown", Port: 80},
+		expectedErr: `services "unknown" not found`,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(
+				pod("pod-1", corev1.PodPending),
+				pod("pod-2", corev1.PodRunning),
+				pod("pod-3", corev1.PodRunning),
+				service,
+			)
+			operation := &operation{
+				client:    client,
+				request:   v1alpha1.HTTP{Target: &tt.target},
+				namespace: "foo",
+			}
+			pod, port, err := operation.forwardedPod(context.TODO())
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantPod, pod.Name)
+				assert.Equal(t, tt.wantPort, port)
+			}
+		})
+	}
+}
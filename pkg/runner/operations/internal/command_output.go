@@ -2,9 +2,11 @@ package internal
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/kyverno/chainsaw/pkg/runner/artifacts"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
 )
 
@@ -33,3 +35,18 @@ func (c *CommandOutput) Sections() []fmt.Stringer {
 	}
 	return sections
 }
+
+// Save writes the command standard and error outputs as artifacts, empty outputs are skipped.
+func (c *CommandOutput) Save(ctx context.Context, name string) error {
+	if o := c.Out(); o != "" {
+		if err := artifacts.Write(ctx, name+"-stdout.log", []byte(o+"\n")); err != nil {
+			return err
+		}
+	}
+	if e := c.Err(); e != "" {
+		if err := artifacts.Write(ctx, name+"-stderr.log", []byte(e+"\n")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,9 +2,12 @@ package internal
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"path/filepath"
 	"testing"
 
+	"github.com/kyverno/chainsaw/pkg/runner/artifacts"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
 	"github.com/stretchr/testify/assert"
 )
@@ -103,3 +106,43 @@ func TestCommandOutput_Sections(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandOutput_Save(t *testing.T) {
+	tests := []struct {
+		name   string
+		stdout string
+		stderr string
+		want   []string
+	}{{
+		name: "none",
+		want: nil,
+	}, {
+		name:   "out",
+		stdout: "foo",
+		want:   []string{"01-command-stdout.log"},
+	}, {
+		name:   "err",
+		stderr: "bar",
+		want:   []string{"01-command-stderr.log"},
+	}, {
+		name:   "both",
+		stdout: "foo",
+		stderr: "bar",
+		want:   []string{"01-command-stdout.log", "02-command-stderr.log"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			recorder := artifacts.NewRecorder(artifacts.New(dir))
+			c := &CommandOutput{}
+			c.Stdout.WriteString(tt.stdout)
+			c.Stderr.WriteString(tt.stderr)
+			assert.NoError(t, c.Save(artifacts.IntoContext(context.TODO(), recorder), "command"))
+			var want []string
+			for _, name := range tt.want {
+				want = append(want, filepath.Join(dir, name))
+			}
+			assert.Equal(t, want, recorder.Paths())
+		})
+	}
+}
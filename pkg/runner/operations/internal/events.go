@@ -0,0 +1,42 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// FormatEvents formats events as a table, sorted by the time they were last seen.
+func FormatEvents(events []corev1.Event) string {
+	sort.SliceStable(events, func(i, j int) bool {
+		return lastSeen(events[i]).Before(lastSeen(events[j]))
+	})
+	var buffer bytes.Buffer
+	w := tabwriter.NewWriter(&buffer, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "LAST SEEN\tTYPE\tREASON\tOBJECT\tMESSAGE")
+	for _, event := range events {
+		var seen string
+		if t := lastSeen(event); !t.IsZero() {
+			seen = t.UTC().Format(time.RFC3339)
+		}
+		object := strings.ToLower(event.InvolvedObject.Kind) + "/" + event.InvolvedObject.Name
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", seen, event.Type, event.Reason, object, strings.TrimSpace(event.Message))
+	}
+	_ = w.Flush()
+	return buffer.String()
+}
+
+func lastSeen(event corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
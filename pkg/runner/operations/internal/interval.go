@@ -1,7 +1,28 @@
 package internal
 
 import (
-	"time"
+	"context"
+	"math"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
-const PollInterval = 50 * time.Millisecond
+// Backoff returns the wait.Backoff corresponding to the polling configuration.
+func Backoff(polling v1alpha1.Polling) wait.Backoff {
+	backoff := wait.Backoff{
+		Duration: polling.IntervalDuration(),
+	}
+	if polling.Backoff != nil {
+		backoff.Factor = float64(polling.Backoff.FactorValue())
+		backoff.Cap = polling.Backoff.MaxIntervalDuration()
+		backoff.Steps = math.MaxInt32
+	}
+	return backoff
+}
+
+// Poll evaluates the condition until it returns true, an error, or the context is cancelled,
+// waiting between attempts according to the polling configuration.
+func Poll(ctx context.Context, polling v1alpha1.Polling, immediate bool, condition wait.ConditionWithContextFunc) error {
+	return Backoff(polling).DelayFunc().Until(ctx, immediate, false, condition)
+}
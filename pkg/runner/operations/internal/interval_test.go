@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		polling v1alpha1.Polling
+		want    wait.Backoff
+	}{{
+		name: "default",
+		want: wait.Backoff{
+			Duration: v1alpha1.DefaultPollInterval,
+		},
+	}, {
+		name: "interval",
+		polling: v1alpha1.Polling{
+			Interval: &metav1.Duration{Duration: time.Second},
+		},
+		want: wait.Backoff{
+			Duration: time.Second,
+		},
+	}, {
+		name: "default backoff",
+		polling: v1alpha1.Polling{
+			Backoff: &v1alpha1.Backoff{},
+		},
+		want: wait.Backoff{
+			Duration: v1alpha1.DefaultPollInterval,
+			Factor:   v1alpha1.DefaultBackoffFactor,
+			Cap:      v1alpha1.DefaultBackoffMaxInterval,
+			Steps:    math.MaxInt32,
+		},
+	}, {
+		name: "backoff",
+		polling: v1alpha1.Polling{
+			Interval: &metav1.Duration{Duration: time.Second},
+			Backoff: &v1alpha1.Backoff{
+				Factor:      ptr.To(3),
+				MaxInterval: &metav1.Duration{Duration: time.Minute},
+			},
+		},
+		want: wait.Backoff{
+			Duration: time.Second,
+			Factor:   3,
+			Cap:      time.Minute,
+			Steps:    math.MaxInt32,
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Backoff(tt.polling))
+		})
+	}
+}
+
+func TestPoll(t *testing.T) {
+	polling := v1alpha1.Polling{
+		Interval: &metav1.Duration{Duration: time.Millisecond},
+		Backoff: &v1alpha1.Backoff{
+			MaxInterval: &metav1.Duration{Duration: 4 * time.Millisecond},
+		},
+	}
+	calls := 0
+	err := Poll(context.TODO(), polling, true, func(ctx context.Context) (bool, error) {
+		calls++
+		return calls == 5, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, calls)
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	err = Poll(ctx, polling, false, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -22,17 +22,21 @@ func Read(ctx context.Context, expected ctrlclient.Object, c client.Client) ([]u
 	} else {
 		var list unstructured.UnstructuredList
 		list.SetGroupVersionKind(gvk)
-		var listOptions []ctrlclient.ListOption
-		if expected.GetNamespace() != "" {
-			listOptions = append(listOptions, ctrlclient.InNamespace(expected.GetNamespace()))
-		}
-		if len(expected.GetLabels()) != 0 {
-			listOptions = append(listOptions, ctrlclient.MatchingLabels(expected.GetLabels()))
-		}
-		if err := c.List(ctx, &list, listOptions...); err != nil {
+		if err := c.List(ctx, &list, listOptions(expected)...); err != nil {
 			return nil, err
 		}
 		results = append(results, list.Items...)
 	}
 	return results, nil
 }
+
+func listOptions(expected ctrlclient.Object) []ctrlclient.ListOption {
+	var listOptions []ctrlclient.ListOption
+	if expected.GetNamespace() != "" {
+		listOptions = append(listOptions, ctrlclient.InNamespace(expected.GetNamespace()))
+	}
+	if len(expected.GetLabels()) != 0 {
+		listOptions = append(listOptions, ctrlclient.MatchingLabels(expected.GetLabels()))
+	}
+	return listOptions
+}
@@ -0,0 +1,23 @@
+package internal
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// FormatResources formats resources as a multi document YAML, managed fields are removed.
+func FormatResources(resources []unstructured.Unstructured) (string, error) {
+	var docs []string
+	for _, resource := range resources {
+		resource := resource.DeepCopy()
+		unstructured.RemoveNestedField(resource.Object, "metadata", "managedFields")
+		data, err := yaml.Marshal(resource.Object)
+		if err != nil {
+			return "", err
+		}
+		docs = append(docs, strings.TrimSpace(string(data)))
+	}
+	return strings.Join(docs, "\n---\n"), nil
+}
@@ -0,0 +1,77 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Watch starts a watch on the resources Read would return for the expected object.
+func Watch(ctx context.Context, expected ctrlclient.Object, c client.Client) (watch.Interface, error) {
+	var list unstructured.UnstructuredList
+	list.SetGroupVersionKind(expected.GetObjectKind().GroupVersionKind())
+	var opts []ctrlclient.ListOption
+	if expected.GetName() != "" {
+		if expected.GetNamespace() != "" {
+			opts = append(opts, ctrlclient.InNamespace(expected.GetNamespace()))
+		}
+		opts = append(opts, ctrlclient.MatchingFields{"metadata.name": expected.GetName()})
+	} else {
+		opts = listOptions(expected)
+	}
+	return c.Watch(ctx, &list, opts...)
+}
+
+// WaitFor evaluates the condition until it returns true, an error, or the context is cancelled.
+// The condition is evaluated once, then every time a resource matching the expected object changes.
+// If the resources can't be watched, or if the watch terminates, it falls back to polling.
+func WaitFor(ctx context.Context, expected ctrlclient.Object, c client.Client, polling v1alpha1.Polling, immediate bool, condition wait.ConditionWithContextFunc) error {
+	watcher, err := Watch(ctx, expected, c)
+	if err != nil {
+		return Poll(ctx, polling, immediate, condition)
+	}
+	defer watcher.Stop()
+	changed, stopped := notify(watcher)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if done, err := condition(ctx); err != nil {
+			return err
+		} else if done {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		case <-stopped:
+			return Poll(ctx, polling, false, condition)
+		}
+	}
+}
+
+// notify drains the watch events, coalescing them into change notifications.
+// The stopped channel is closed when the watch terminates or reports an error.
+func notify(watcher watch.Interface) (<-chan struct{}, <-chan struct{}) {
+	changed := make(chan struct{}, 1)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for event := range watcher.ResultChan() {
+			if event.Type == watch.Error {
+				return
+			}
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	return changed, stopped
+}
@@ -0,0 +1,189 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	tclient "github.com/kyverno/chainsaw/pkg/client/testing"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestWatch(t *testing.T) {
+	tests := []struct {
+		name          string
+		expected      *unstructured.Unstructured
+		namespace     string
+		fieldSelector string
+		labelSelector string
+	}{{
+		name: "with name",
+		expected: &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata": map[string]any{
+					"name":      "test-pod",
+					"namespace": "default",
+					"labels": map[string]any{
+						"app": "test",
+					},
+				},
+			},
+		},
+		namespace:     "default",
+		fieldSelector: "metadata.name=test-pod",
+	}, {
+		name: "with labels",
+		expected: &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata": map[string]any{
+					"namespace": "default",
+					"labels": map[string]any{
+						"app": "test",
+					},
+				},
+			},
+		},
+		namespace:     "default",
+		labelSelector: "app=test",
+	}, {
+		name: "cluster scoped",
+		expected: &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Namespace",
+			},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &tclient.FakeClient{
+				WatchFn: func(_ context.Context, _ int, list ctrlclient.ObjectList, opts ...ctrlclient.ListOption) (watch.Interface, error) {
+					assert.Equal(t, tt.expected.GroupVersionKind(), list.GetObjectKind().GroupVersionKind())
+					var options ctrlclient.ListOptions
+					options.ApplyOptions(opts)
+					assert.Equal(t, tt.namespace, options.Namespace)
+					if tt.fieldSelector == "" {
+						assert.Nil(t, options.FieldSelector)
+					} else {
+						assert.Equal(t, fields.ParseSelectorOrDie(tt.fieldSelector), options.FieldSelector)
+					}
+					if tt.labelSelector == "" {
+						assert.Nil(t, options.LabelSelector)
+					} else {
+						selector, err := labels.Parse(tt.labelSelector)
+						assert.NoError(t, err)
+						assert.Equal(t, selector, options.LabelSelector)
+					}
+					return watch.NewFake(), nil
+				},
+			}
+			watcher, err := Watch(context.TODO(), tt.expected, client)
+			assert.NoError(t, err)
+			assert.NotNil(t, watcher)
+			assert.Equal(t, 1, client.NumCalls())
+		})
+	}
+}
+
+func TestWaitFor(t *testing.T) {
+	expected := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]any{
+				"name": "test-pod",
+			},
+		},
+	}
+	t.Run("polling fallback", func(t *testing.T) {
+		calls := 0
+		err := WaitFor(context.TODO(), expected, &tclient.FakeClient{}, v1alpha1.Polling{}, false, func(ctx context.Context) (bool, error) {
+			calls++
+			return calls == 3, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+	t.Run("watch events", func(t *testing.T) {
+		watcher := watch.NewFake()
+		client := &tclient.FakeClient{
+			WatchFn: func(_ context.Context, _ int, _ ctrlclient.ObjectList, _ ...ctrlclient.ListOption) (watch.Interface, error) {
+				return watcher, nil
+			},
+		}
+		calls := 0
+		evaluated := make(chan struct{})
+		go func() {
+			<-evaluated
+			watcher.Modify(expected)
+		}()
+		err := WaitFor(context.TODO(), expected, client, v1alpha1.Polling{}, false, func(ctx context.Context) (bool, error) {
+			calls++
+			if calls == 1 {
+				close(evaluated)
+			}
+			return calls == 2, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+		assert.True(t, watcher.IsStopped())
+	})
+	t.Run("watch error", func(t *testing.T) {
+		watcher := watch.NewFake()
+		client := &tclient.FakeClient{
+			WatchFn: func(_ context.Context, _ int, _ ctrlclient.ObjectList, _ ...ctrlclient.ListOption) (watch.Interface, error) {
+				return watcher, nil
+			},
+		}
+		calls := 0
+		evaluated := make(chan struct{})
+		go func() {
+			<-evaluated
+			watcher.Error(expected)
+		}()
+		err := WaitFor(context.TODO(), expected, client, v1alpha1.Polling{}, false, func(ctx context.Context) (bool, error) {
+			calls++
+			if calls == 1 {
+				close(evaluated)
+			}
+			return calls == 3, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+	t.Run("condition error", func(t *testing.T) {
+		client := &tclient.FakeClient{
+			WatchFn: func(_ context.Context, _ int, _ ctrlclient.ObjectList, _ ...ctrlclient.ListOption) (watch.Interface, error) {
+				return watch.NewFake(), nil
+			},
+		}
+		err := WaitFor(context.TODO(), expected, client, v1alpha1.Polling{}, false, func(ctx context.Context) (bool, error) {
+			return false, errors.New("dummy error")
+		})
+		assert.EqualError(t, err, "dummy error")
+	})
+	t.Run("context cancelled", func(t *testing.T) {
+		client := &tclient.FakeClient{
+			WatchFn: func(_ context.Context, _ int, _ ctrlclient.ObjectList, _ ...ctrlclient.ListOption) (watch.Interface, error) {
+				return watch.NewFake(), nil
+			},
+		}
+		ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+		defer cancel()
+		err := WaitFor(ctx, expected, client, v1alpha1.Polling{}, false, func(ctx context.Context) (bool, error) {
+			return false, nil
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
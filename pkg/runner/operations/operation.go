@@ -2,8 +2,13 @@ package operations
 
 import (
 	"context"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
 )
 
+// Outputs contains the values captured by an operation, indexed by binding name.
+type Outputs = map[string]any
+
 type Operation interface {
-	Exec(ctx context.Context) error
+	Exec(ctx context.Context, bindings binding.Bindings) (Outputs, error)
 }
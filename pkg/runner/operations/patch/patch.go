@@ -0,0 +1,87 @@
+package patch
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/client"
+	"github.com/kyverno/chainsaw/pkg/runner/check"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"go.uber.org/multierr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type operation struct {
+	client     client.Client
+	obj        unstructured.Unstructured
+	namespacer namespacer.Namespacer
+	patch      ctrlclient.Patch
+	expect     []v1alpha1.Expectation
+}
+
+func New(client client.Client, obj unstructured.Unstructured, namespacer namespacer.Namespacer, patchType types.PatchType, patch []byte, expect ...v1alpha1.Expectation) operations.Operation {
+	return &operation{
+		client:     client,
+		obj:        obj,
+		namespacer: namespacer,
+		patch:      ctrlclient.RawPatch(patchType, patch),
+		expect:     expect,
+	}
+}
+
+func (o *operation) Exec(ctx context.Context, bindings binding.Bindings) (_ operations.Outputs, err error) {
+	logger := internal.GetLogger(ctx, &o.obj)
+	defer func() {
+		internal.LogEnd(logger, logging.Patch, err)
+	}()
+	if err := internal.ApplyNamespacer(o.namespacer, &o.obj); err != nil {
+		return nil, err
+	}
+	internal.LogStart(logger, logging.Patch)
+	return nil, o.execute(ctx, bindings)
+}
+
+func (o *operation) execute(ctx context.Context, bindings binding.Bindings) error {
+	resources, err := internal.Read(ctx, &o.obj, o.client)
+	if err != nil {
+		return err
+	}
+	if len(resources) == 0 {
+		return errors.New("no resource found to patch")
+	}
+	return o.patchResources(ctx, bindings, resources...)
+}
+
+func (o *operation) patchResources(ctx context.Context, bindings binding.Bindings, resources ...unstructured.Unstructured) error {
+	var errs []error
+	for i := range resources {
+		resource := &resources[i]
+		err := o.client.Patch(ctx, resource, o.patch)
+		if err := o.handleCheck(ctx, bindings, *resource, err); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return multierr.Combine(errs...)
+}
+
+func (o *operation) handleCheck(ctx context.Context, bindings binding.Bindings, resource unstructured.Unstructured, err error) error {
+	if bindings == nil {
+		bindings = binding.NewBindings()
+	}
+	if err == nil {
+		bindings = bindings.Register("$error", binding.NewBinding(nil))
+	} else {
+		bindings = bindings.Register("$error", binding.NewBinding(err.Error()))
+	}
+	if matched, err := check.Expectations(ctx, resource, bindings, o.expect...); matched {
+		return err
+	}
+	return err
+}
@@ -0,0 +1,183 @@
+package patch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/client"
+	tclient "github.com/kyverno/chainsaw/pkg/client/testing"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
+	ttesting "github.com/kyverno/chainsaw/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_operationPatch(t *testing.T) {
+	pod := unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]any{
+				"name": "test-pod",
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		object       unstructured.Unstructured
+		patchType    types.PatchType
+		patch        []byte
+		client       *tclient.FakeClient
+		namespacer   func(c client.Client) namespacer.Namespacer
+		expect       []v1alpha1.Expectation
+		expectedErr  error
+		expectedLogs []string
+	}{{
+		name:      "not found",
+		object:    pod,
+		patchType: types.MergePatchType,
+		patch:     []byte(`{"metadata":{"labels":{"foo":"bar"}}}`),
+		client: &tclient.FakeClient{
+			GetFn: func(_ context.Context, _ int, key ctrlclient.ObjectKey, obj ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+				return kerrors.NewNotFound(obj.GetObjectKind().GroupVersionKind().GroupVersion().WithResource("pod").GroupResource(), key.Name)
+			},
+		},
+		expectedErr:  kerrors.NewNotFound(corev1.Resource("pod"), "test-pod"),
+		expectedLogs: []string{"PATCH: RUN - []", "PATCH: ERROR - [=== ERROR\npod \"test-pod\" not found]"},
+	}, {
+		name: "no match",
+		object: unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+			},
+		},
+		patchType: types.MergePatchType,
+		patch:     []byte(`{"metadata":{"labels":{"foo":"bar"}}}`),
+		client: &tclient.FakeClient{
+			ListFn: func(_ context.Context, _ int, _ ctrlclient.ObjectList, _ ...ctrlclient.ListOption) error {
+				return nil
+			},
+		},
+		expectedErr:  errors.New("no resource found to patch"),
+		expectedLogs: []string{"PATCH: RUN - []", "PATCH: ERROR - [=== ERROR\nno resource found to patch]"},
+	}, {
+		name:      "failed patch",
+		object:    pod,
+		patchType: types.MergePatchType,
+		patch:     []byte(`{"metadata":{"labels":{"foo":"bar"}}}`),
+		client: &tclient.FakeClient{
+			GetFn: func(_ context.Context, _ int, _ ctrlclient.ObjectKey, _ ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+				return nil
+			},
+			PatchFn: func(_ context.Context, _ int, _ ctrlclient.Object, _ ctrlclient.Patch, _ ...ctrlclient.PatchOption) error {
+				return kerrors.NewInternalError(errors.New("failed to patch the pod"))
+			},
+		},
+		expectedErr:  kerrors.NewInternalError(errors.New("failed to patch the pod")),
+		expectedLogs: []string{"PATCH: RUN - []", "PATCH: ERROR - [=== ERROR\nInternal error occurred: failed to patch the pod]"},
+	}, {
+		name:      "ok",
+		object:    pod,
+		patchType: types.JSONPatchType,
+		patch:     []byte(`[{"op":"add","path":"/metadata/labels","value":{"foo":"bar"}}]`),
+		client: &tclient.FakeClient{
+			GetFn: func(_ context.Context, _ int, _ ctrlclient.ObjectKey, _ ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+				return nil
+			},
+			PatchFn: func(ctx context.Context, _ int, _ ctrlclient.Object, patch ctrlclient.Patch, _ ...ctrlclient.PatchOption) error {
+				t := ttesting.FromContext(ctx)
+				assert.Equal(t, types.JSONPatchType, patch.Type())
+				data, err := patch.Data(nil)
+				assert.NoError(t, err)
+				assert.Equal(t, `[{"op":"add","path":"/metadata/labels","value":{"foo":"bar"}}]`, string(data))
+				return nil
+			},
+		},
+		expectedErr:  nil,
+		expectedLogs: []string{"PATCH: RUN - []", "PATCH: DONE - []"},
+	}, {
+		name:      "with namespacer",
+		object:    pod,
+		patchType: types.StrategicMergePatchType,
+		patch:     []byte(`{"metadata":{"labels":{"foo":"bar"}}}`),
+		client: &tclient.FakeClient{
+			GetFn: func(ctx context.Context, _ int, key ctrlclient.ObjectKey, _ ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+				t := ttesting.FromContext(ctx)
+				assert.Equal(t, "bar", key.Namespace)
+				return nil
+			},
+			PatchFn: func(ctx context.Context, _ int, _ ctrlclient.Object, patch ctrlclient.Patch, _ ...ctrlclient.PatchOption) error {
+				t := ttesting.FromContext(ctx)
+				assert.Equal(t, types.StrategicMergePatchType, patch.Type())
+				return nil
+			},
+			IsObjectNamespacedFn: func(int, runtime.Object) (bool, error) {
+				return true, nil
+			},
+		},
+		namespacer: func(c client.Client) namespacer.Namespacer {
+			return namespacer.New(c, "bar")
+		},
+		expectedErr:  nil,
+		expectedLogs: []string{"PATCH: RUN - []", "PATCH: DONE - []"},
+	}, {
+		name:      "with check",
+		object:    pod,
+		patchType: types.MergePatchType,
+		patch:     []byte(`{"spec":{"containers":null}}`),
+		client: &tclient.FakeClient{
+			GetFn: func(_ context.Context, _ int, _ ctrlclient.ObjectKey, _ ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+				return nil
+			},
+			PatchFn: func(_ context.Context, _ int, _ ctrlclient.Object, _ ctrlclient.Patch, _ ...ctrlclient.PatchOption) error {
+				return errors.New("dummy error")
+			},
+		},
+		expect: []v1alpha1.Expectation{{
+			Check: v1alpha1.Check{
+				Value: map[string]any{
+					"($error == 'dummy error')": true,
+				},
+			},
+		}},
+		expectedErr:  nil,
+		expectedLogs: []string{"PATCH: RUN - []", "PATCH: DONE - []"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			var nspacer namespacer.Namespacer
+			if tt.namespacer != nil {
+				nspacer = tt.namespacer(tt.client)
+			}
+			operation := New(
+				tt.client,
+				tt.object,
+				nspacer,
+				tt.patchType,
+				tt.patch,
+				tt.expect...,
+			)
+			logger := &tlogging.FakeLogger{}
+			_, err := operation.Exec(ttesting.IntoContext(logging.IntoContext(ctx, logger), t), nil)
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedLogs, logger.Logs)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package podlogs
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/runner/artifacts"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/kyverno/ext/output/color"
+	"go.uber.org/multierr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+)
+
+// defaultSelectorTail matches the default number of lines kubectl retrieves when using a selector.
+const defaultSelectorTail = 10
+
+type operation struct {
+	client    kubernetes.Interface
+	collector v1alpha1.PodLogs
+	namespace string
+}
+
+func New(client kubernetes.Interface, collector v1alpha1.PodLogs, namespace string) operations.Operation {
+	return &operation{
+		client:    client,
+		collector: collector,
+		namespace: namespace,
+	}
+}
+
+func (o *operation) Exec(ctx context.Context, _ binding.Bindings) (_ operations.Outputs, err error) {
+	logger := internal.GetLogger(ctx, nil)
+	defer func() {
+		internal.LogEnd(logger, logging.PodLogs, err)
+	}()
+	internal.LogStart(logger, logging.PodLogs)
+	return nil, o.execute(ctx)
+}
+
+func (o *operation) execute(ctx context.Context) error {
+	if o.collector.Name != "" && o.collector.Selector != "" {
+		return errors.New("name cannot be provided when a selector is specified")
+	}
+	namespace := o.collector.Namespace
+	if namespace == "" {
+		namespace = o.namespace
+	}
+	pods, err := o.pods(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	var logs []string
+	var errs []error
+	for _, pod := range pods {
+		for _, container := range containers(pod, o.collector.Container) {
+			// containers that never restarted have no previous logs, the API would return an error
+			if o.collector.Previous && !restarted(pod, container) {
+				continue
+			}
+			lines, err := o.logs(ctx, pod, container)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("pod/%s/%s - %w", pod.Name, container, err))
+			}
+			logs = append(logs, lines...)
+		}
+	}
+	if len(logs) != 0 {
+		content := strings.Join(logs, "\n")
+		if logger := logging.FromContext(ctx); logger != nil {
+			logger.Log(logging.PodLogs, logging.LogStatus, color.BoldFgCyan, logging.Section("LOGS", content))
+		}
+		if err := artifacts.Write(ctx, "pod-logs.log", []byte(content+"\n")); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return multierr.Combine(errs...)
+}
+
+func (o *operation) pods(ctx context.Context, namespace string) ([]corev1.Pod, error) {
+	if o.collector.Name != "" {
+		pod, err := o.client.CoreV1().Pods(namespace).Get(ctx, o.collector.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return []corev1.Pod{*pod}, nil
+	}
+	list, err := o.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: o.collector.Selector})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+func (o *operation) logs(ctx context.Context, pod corev1.Pod, container string) ([]string, error) {
+	stream, err := o.client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, o.logOptions(container)).Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	prefix := fmt.Sprintf("[pod/%s/%s]", pod.Name, container)
+	var lines []string
+	scanner := bufio.NewScanner(stream)
+	for scanner.Scan() {
+		lines = append(lines, prefix+" "+scanner.Text())
+	}
+	return lines, scanner.Err()
+}
+
+func (o *operation) logOptions(container string) *corev1.PodLogOptions {
+	options := corev1.PodLogOptions{
+		Container: container,
+		Previous:  o.collector.Previous,
+	}
+	if o.collector.Since != nil {
+		// the api server only accepts a positive number of seconds
+		options.SinceSeconds = ptr.To(max(1, int64(math.Ceil(o.collector.Since.Seconds()))))
+	}
+	if o.collector.Tail != nil {
+		if *o.collector.Tail >= 0 {
+			options.TailLines = ptr.To(int64(*o.collector.Tail))
+		}
+	} else if o.collector.Name == "" {
+		options.TailLines = ptr.To(int64(defaultSelectorTail))
+	}
+	return &options
+}
+
+func containers(pod corev1.Pod, container string) []string {
+	if container != "" {
+		return []string{container}
+	}
+	var names []string
+	for _, c := range pod.Spec.InitContainers {
+		names = append(names, c.Name)
+	}
+	for _, c := range pod.Spec.Containers {
+		names = append(names, c.Name)
+	}
+	for _, c := range pod.Spec.EphemeralContainers {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+func restarted(pod corev1.Pod, container string) bool {
+	var statuses []corev1.ContainerStatus
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	statuses = append(statuses, pod.Status.EphemeralContainerStatuses...)
+	for _, status := range statuses {
+		if status.Name == container {
+			return status.RestartCount != 0
+		}
+	}
+	return false
+}
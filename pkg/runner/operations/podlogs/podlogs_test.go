@@ -0,0 +1,211 @@
+package podlogs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	ttesting "github.com/kyverno/chainsaw/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func Test_operation_Exec(t *testing.T) {
+	pod := func(name string, labels map[string]string, containers ...string) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "foo",
+				Labels:    labels,
+			},
+		}
+		for _, container := range containers {
+			pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: container})
+		}
+		return pod
+	}
+	tests := []struct {
+		name         string
+		collector    v1alpha1.PodLogs
+		namespace    string
+		expectedErr  string
+		expectedLogs []string
+	}{{
+		name: "name and selector",
+		collector: v1alpha1.PodLogs{
+			Name:     "foo",
+			Selector: "app=foo",
+		},
+		namespace:    "foo",
+		expectedErr:  "name cannot be provided when a selector is specified",
+		expectedLogs: []string{"LOGS: RUN - []", "LOGS: ERROR - [=== ERROR\nname cannot be provided when a selector is specified]"},
+	}, {
+		name: "with name",
+		collector: v1alpha1.PodLogs{
+			Name: "pod-1",
+		},
+		namespace: "foo",
+		expectedLogs: []string{
+			"LOGS: RUN - []",
+			"LOGS: LOG - [=== LOGS\n[pod/pod-1/a] fake logs\n[pod/pod-1/b] fake logs]",
+			"LOGS: DONE - []",
+		},
+	}, {
+		name: "with container",
+		collector: v1alpha1.PodLogs{
+			Name:      "pod-1",
+			Namespace: "foo",
+			Container: "b",
+		},
+		namespace: "bar",
+		expectedLogs: []string{
+			"LOGS: RUN - []",
+			"LOGS: LOG - [=== LOGS\n[pod/pod-1/b] fake logs]",
+			"LOGS: DONE - []",
+		},
+	}, {
+		name: "with selector",
+		collector: v1alpha1.PodLogs{
+			Selector: "app=foo",
+		},
+		namespace: "foo",
+		expectedLogs: []string{
+			"LOGS: RUN - []",
+			"LOGS: LOG - [=== LOGS\n[pod/pod-2/c] fake logs]",
+			"LOGS: DONE - []",
+		},
+	}, {
+		name: "with previous",
+		collector: v1alpha1.PodLogs{
+			Name:     "pod-1",
+			Previous: true,
+		},
+		namespace: "foo",
+		expectedLogs: []string{
+			"LOGS: RUN - []",
+			"LOGS: LOG - [=== LOGS\n[pod/pod-1/b] fake logs]",
+			"LOGS: DONE - []",
+		},
+	}, {
+		name: "with previous and no restart",
+		collector: v1alpha1.PodLogs{
+			Selector: "app=foo",
+			Previous: true,
+		},
+		namespace: "foo",
+		expectedLogs: []string{
+			"LOGS: RUN - []",
+			"LOGS: DONE - []",
+		},
+	}, {
+		name: "not found",
+		collector: v1alpha1.PodLogs{
+			Name: "pod-1",
+		},
+		namespace:    "bar",
+		expectedErr:  `pods "pod-1" not found`,
+		expectedLogs: []string{"LOGS: RUN - []", "LOGS: ERROR - [=== ERROR\npods \"pod-1\" not found]"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restarted := pod("pod-1", nil, "a", "b")
+			restarted.Status.ContainerStatuses = []corev1.ContainerStatus{
+				{Name: "a"},
+				{Name: "b", RestartCount: 1},
+			}
+			client := fake.NewSimpleClientset(
+				restarted,
+				pod("pod-2", map[string]string{"app": "foo"}, "c"),
+			)
+			operation := New(client, tt.collector, tt.namespace)
+			logger := &tlogging.FakeLogger{}
+			_, err := operation.Exec(ttesting.IntoContext(logging.IntoContext(context.TODO(), logger), t), nil)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedLogs, logger.Logs)
+		})
+	}
+}
+
+func Test_operation_logOptions(t *testing.T) {
+	tests := []struct {
+		name      string
+		collector v1alpha1.PodLogs
+		want      *corev1.PodLogOptions
+	}{{
+		name: "with name",
+		collector: v1alpha1.PodLogs{
+			Name: "foo",
+		},
+		want: &corev1.PodLogOptions{
+			Container: "bar",
+		},
+	}, {
+		name: "with selector",
+		collector: v1alpha1.PodLogs{
+			Selector: "app=foo",
+		},
+		want: &corev1.PodLogOptions{
+			Container: "bar",
+			TailLines: ptr.To[int64](10),
+		},
+	}, {
+		name: "with all tail",
+		collector: v1alpha1.PodLogs{
+			Selector: "app=foo",
+			Tail:     ptr.To(-1),
+		},
+		want: &corev1.PodLogOptions{
+			Container: "bar",
+		},
+	}, {
+		name: "with tail, since and previous",
+		collector: v1alpha1.PodLogs{
+			Name:     "foo",
+			Tail:     ptr.To(20),
+			Since:    &metav1.Duration{Duration: 2 * time.Minute},
+			Previous: true,
+		},
+		want: &corev1.PodLogOptions{
+			Container:    "bar",
+			Previous:     true,
+			SinceSeconds: ptr.To[int64](120),
+			TailLines:    ptr.To[int64](20),
+		},
+	}, {
+		name: "with sub second since",
+		collector: v1alpha1.PodLogs{
+			Name:  "foo",
+			Since: &metav1.Duration{Duration: 500 * time.Millisecond},
+		},
+		want: &corev1.PodLogOptions{
+			Container:    "bar",
+			SinceSeconds: ptr.To[int64](1),
+		},
+	}, {
+		name: "with since rounded up",
+		collector: v1alpha1.PodLogs{
+			Name:  "foo",
+			Since: &metav1.Duration{Duration: 1500 * time.Millisecond},
+		},
+		want: &corev1.PodLogOptions{
+			Container:    "bar",
+			SinceSeconds: ptr.To[int64](2),
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &operation{collector: tt.collector}
+			assert.Equal(t, tt.want, o.logOptions("bar"))
+		})
+	}
+}
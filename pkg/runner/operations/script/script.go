@@ -8,6 +8,7 @@ import (
 
 	"github.com/jmespath-community/go-jmespath/pkg/binding"
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	runnerbindings "github.com/kyverno/chainsaw/pkg/runner/bindings"
 	"github.com/kyverno/chainsaw/pkg/runner/check"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
 	"github.com/kyverno/chainsaw/pkg/runner/operations"
@@ -29,17 +30,17 @@ func New(script v1alpha1.Script, basePath string, namespace string) operations.O
 	}
 }
 
-func (o *operation) Exec(ctx context.Context) (err error) {
+func (o *operation) Exec(ctx context.Context, bindings binding.Bindings) (_ operations.Outputs, err error) {
 	logger := internal.GetLogger(ctx, nil)
 	defer func() {
 		internal.LogEnd(logger, logging.Script, err)
 	}()
 	cmd, err := o.createCommand(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	internal.LogStart(logger, logging.Script, logging.Section("COMMAND", cmd.String()))
-	return o.execute(ctx, cmd)
+	return o.execute(ctx, bindings, cmd)
 }
 
 func (o *operation) createCommand(ctx context.Context) (*exec.Cmd, error) {
@@ -58,7 +59,7 @@ func (o *operation) createCommand(ctx context.Context) (*exec.Cmd, error) {
 	return cmd, nil
 }
 
-func (o *operation) execute(ctx context.Context, cmd *exec.Cmd) error {
+func (o *operation) execute(ctx context.Context, bindings binding.Bindings, cmd *exec.Cmd) (operations.Outputs, error) {
 	logger := internal.GetLogger(ctx, nil)
 	var output internal.CommandOutput
 	if !o.script.SkipLogOutput {
@@ -71,10 +72,14 @@ func (o *operation) execute(ctx context.Context, cmd *exec.Cmd) error {
 	cmd.Stdout = &output.Stdout
 	cmd.Stderr = &output.Stderr
 	err := cmd.Run()
-	if o.script.Check == nil || o.script.Check.Value == nil {
-		return err
+	if !o.script.SkipLogOutput {
+		if err := output.Save(ctx, "script"); err != nil {
+			return nil, err
+		}
+	}
+	if bindings == nil {
+		bindings = binding.NewBindings()
 	}
-	bindings := binding.NewBindings()
 	if err == nil {
 		bindings = bindings.Register("$error", binding.NewBinding(nil))
 	} else {
@@ -82,9 +87,14 @@ func (o *operation) execute(ctx context.Context, cmd *exec.Cmd) error {
 	}
 	bindings = bindings.Register("$stdout", binding.NewBinding(output.Out()))
 	bindings = bindings.Register("$stderr", binding.NewBinding(output.Err()))
-	if errs, err := check.Check(ctx, nil, bindings, o.script.Check); err != nil {
-		return err
-	} else {
-		return errs.ToAggregate()
+	if o.script.Check == nil || o.script.Check.Value == nil {
+		if err != nil {
+			return nil, err
+		}
+	} else if errs, err := check.Check(ctx, nil, bindings, o.script.Check); err != nil {
+		return nil, err
+	} else if err := errs.ToAggregate(); err != nil {
+		return nil, err
 	}
+	return runnerbindings.ProcessOutputs(ctx, bindings, nil, o.script.Outputs...)
 }
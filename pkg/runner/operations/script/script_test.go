@@ -7,6 +7,7 @@ import (
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
 	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,6 +17,7 @@ func Test_operationScript(t *testing.T) {
 		script    v1alpha1.Script
 		basePath  string
 		namespace string
+		want      operations.Outputs
 		wantErr   bool
 	}{{
 		name: "Test with valid Script",
@@ -92,6 +94,22 @@ func Test_operationScript(t *testing.T) {
 		basePath:  "..",
 		namespace: "test-namespace",
 		wantErr:   true,
+	}, {
+		name: "with outputs",
+		script: v1alpha1.Script{
+			Content:       "echo hello",
+			SkipLogOutput: true,
+			Outputs: []v1alpha1.Output{{
+				Binding: v1alpha1.Binding{
+					Name:  "greeting",
+					Value: v1alpha1.Check{Value: "($stdout)"},
+				},
+			}},
+		},
+		namespace: "test-namespace",
+		want: map[string]any{
+			"greeting": "hello",
+		},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -101,12 +119,13 @@ func Test_operationScript(t *testing.T) {
 				tt.basePath,
 				tt.namespace,
 			)
-			err := operation.Exec(ctx)
+			outputs, err := operation.Exec(ctx, nil)
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
 			}
+			assert.Equal(t, tt.want, outputs)
 		})
 	}
 }
@@ -2,34 +2,113 @@ package sleep
 
 import (
 	"context"
-	"time"
+	"fmt"
 
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/client"
+	runnerbindings "github.com/kyverno/chainsaw/pkg/runner/bindings"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
 	"github.com/kyverno/chainsaw/pkg/runner/operations"
 	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/chainsaw/pkg/runner/template"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 type operation struct {
-	duration v1alpha1.Sleep
+	client     client.Client
+	namespacer namespacer.Namespacer
+	template   bool
+	polling    v1alpha1.Polling
+	sleep      v1alpha1.Sleep
 }
 
-func New(duration v1alpha1.Sleep) operations.Operation {
+func New(client client.Client, namespacer namespacer.Namespacer, template bool, polling v1alpha1.Polling, sleep v1alpha1.Sleep) operations.Operation {
 	return &operation{
-		duration: duration,
+		client:     client,
+		namespacer: namespacer,
+		template:   template,
+		polling:    polling,
+		sleep:      sleep,
 	}
 }
 
-func (o *operation) Exec(ctx context.Context) (err error) {
-	logger := internal.GetLogger(ctx, nil)
+func (o *operation) Exec(ctx context.Context, bindings binding.Bindings) (_ operations.Outputs, err error) {
+	if o.sleep.Until == nil {
+		logger := internal.GetLogger(ctx, nil)
+		defer func() {
+			internal.LogEnd(logger, logging.Sleep, err)
+		}()
+		internal.LogStart(logger, logging.Sleep)
+		return nil, o.execute(ctx)
+	}
+	var obj unstructured.Unstructured
+	obj.SetAPIVersion(o.sleep.Until.APIVersion)
+	obj.SetKind(o.sleep.Until.Kind)
+	obj.SetName(o.sleep.Until.Name)
+	obj.SetNamespace(o.sleep.Until.Namespace)
+	obj.SetLabels(o.sleep.Until.Labels)
+	logger := internal.GetLogger(ctx, &obj)
 	defer func() {
 		internal.LogEnd(logger, logging.Sleep, err)
 	}()
-	internal.LogStart(logger, logging.Sleep)
-	return o.execute(ctx)
+	if o.template {
+		if err := template.Resource(ctx, &obj, bindings); err != nil {
+			return nil, err
+		}
+	}
+	if err := internal.ApplyNamespacer(o.namespacer, &obj); err != nil {
+		return nil, err
+	}
+	internal.LogStart(logger, logging.Sleep, logging.Section("UNTIL", o.sleep.Until.Predicate))
+	return nil, o.executeUntil(ctx, bindings, &obj)
 }
 
 func (o *operation) execute(ctx context.Context) error {
-	time.Sleep(o.duration.Duration.Duration)
-	return nil
+	sleepCtx, cancel := context.WithTimeout(ctx, o.sleep.Duration.Duration)
+	defer cancel()
+	<-sleepCtx.Done()
+	return done(ctx)
+}
+
+func (o *operation) executeUntil(ctx context.Context, bindings binding.Bindings, obj *unstructured.Unstructured) error {
+	sleepCtx, cancel := context.WithTimeout(ctx, o.sleep.Duration.Duration)
+	defer cancel()
+	err := internal.WaitFor(sleepCtx, obj, o.client, o.polling, false, func(ctx context.Context) (bool, error) {
+		candidates, err := internal.Read(ctx, obj, o.client)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		if len(candidates) == 0 {
+			return false, nil
+		}
+		for i := range candidates {
+			result, err := runnerbindings.Execute(ctx, o.sleep.Until.Predicate, candidates[i].UnstructuredContent(), bindings)
+			if err != nil {
+				return false, fmt.Errorf("failed to evaluate expression %s (%w)", o.sleep.Until.Predicate, err)
+			}
+			if result != true {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err == nil {
+		return nil
+	}
+	if sleepCtx.Err() != nil {
+		return done(ctx)
+	}
+	return err
+}
+
+// done is called once the sleep context is done, it returns the error of the parent context
+// when it was cancelled or reached its own deadline, and nil when the sleep duration elapsed.
+func done(parent context.Context) error {
+	return parent.Err()
 }
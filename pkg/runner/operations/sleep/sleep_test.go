@@ -6,39 +6,167 @@ import (
 	"time"
 
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	tclient "github.com/kyverno/chainsaw/pkg/client/testing"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
 	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
 	ttesting "github.com/kyverno/chainsaw/pkg/testing"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func Test_operation_Exec(t *testing.T) {
+	// ready returns a pod getter, the pod becomes ready after the given number of calls
+	ready := func(after int) func(context.Context, int, ctrlclient.ObjectKey, ctrlclient.Object, ...ctrlclient.GetOption) error {
+		return func(_ context.Context, call int, _ ctrlclient.ObjectKey, obj ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+			obj.(*unstructured.Unstructured).Object = map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata": map[string]any{
+					"name": "test-pod",
+				},
+				"status": map[string]any{
+					"ready": call >= after,
+				},
+			}
+			return nil
+		}
+	}
+	until := func(predicate string) *v1alpha1.SleepUntil {
+		return &v1alpha1.SleepUntil{
+			ObjectReference: v1alpha1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Pod",
+				ObjectSelector: v1alpha1.ObjectSelector{
+					Name: "test-pod",
+				},
+			},
+			Predicate: predicate,
+		}
+	}
 	tests := []struct {
 		name         string
 		sleep        v1alpha1.Sleep
+		getFn        func(context.Context, int, ctrlclient.ObjectKey, ctrlclient.Object, ...ctrlclient.GetOption) error
+		cancelled    bool
+		deadline     time.Duration
+		minDuration  time.Duration
+		maxDuration  time.Duration
+		expectedErr  string
 		expectedLogs []string
 	}{{
 		name:         "zero",
 		sleep:        v1alpha1.Sleep{},
 		expectedLogs: []string{"SLEEP: RUN - []", "SLEEP: DONE - []"},
 	}, {
-		name: "1s",
+		name: "100ms",
 		sleep: v1alpha1.Sleep{
-			Duration: metav1.Duration{Duration: time.Second},
+			Duration: metav1.Duration{Duration: 100 * time.Millisecond},
 		},
+		minDuration:  100 * time.Millisecond,
 		expectedLogs: []string{"SLEEP: RUN - []", "SLEEP: DONE - []"},
+	}, {
+		name: "cancelled",
+		sleep: v1alpha1.Sleep{
+			Duration: metav1.Duration{Duration: time.Minute},
+		},
+		cancelled:    true,
+		maxDuration:  time.Second,
+		expectedErr:  "context canceled",
+		expectedLogs: []string{"SLEEP: RUN - []", "SLEEP: ERROR - [=== ERROR\ncontext canceled]"},
+	}, {
+		name: "deadline",
+		sleep: v1alpha1.Sleep{
+			Duration: metav1.Duration{Duration: time.Minute},
+		},
+		deadline:     50 * time.Millisecond,
+		maxDuration:  time.Second,
+		expectedErr:  "context deadline exceeded",
+		expectedLogs: []string{"SLEEP: RUN - []", "SLEEP: ERROR - [=== ERROR\ncontext deadline exceeded]"},
+	}, {
+		name: "until",
+		sleep: v1alpha1.Sleep{
+			Duration: metav1.Duration{Duration: time.Minute},
+			Until:    until("status.ready"),
+		},
+		getFn:        ready(2),
+		maxDuration:  time.Second,
+		expectedLogs: []string{"SLEEP: RUN - [=== UNTIL\nstatus.ready]", "SLEEP: DONE - []"},
+	}, {
+		name: "until not satisfied",
+		sleep: v1alpha1.Sleep{
+			Duration: metav1.Duration{Duration: 100 * time.Millisecond},
+			Until:    until("status.ready"),
+		},
+		getFn:        ready(1000),
+		minDuration:  100 * time.Millisecond,
+		expectedLogs: []string{"SLEEP: RUN - [=== UNTIL\nstatus.ready]", "SLEEP: DONE - []"},
+	}, {
+		name: "until cancelled",
+		sleep: v1alpha1.Sleep{
+			Duration: metav1.Duration{Duration: time.Minute},
+			Until:    until("status.ready"),
+		},
+		getFn:        ready(1000),
+		cancelled:    true,
+		maxDuration:  time.Second,
+		expectedErr:  "context canceled",
+		expectedLogs: []string{"SLEEP: RUN - [=== UNTIL\nstatus.ready]", "SLEEP: ERROR - [=== ERROR\ncontext canceled]"},
+	}, {
+		name: "until deadline",
+		sleep: v1alpha1.Sleep{
+			Duration: metav1.Duration{Duration: time.Minute},
+			Until:    until("status.ready"),
+		},
+		getFn:        ready(1000),
+		deadline:     50 * time.Millisecond,
+		maxDuration:  time.Second,
+		expectedErr:  "context deadline exceeded",
+		expectedLogs: []string{"SLEEP: RUN - [=== UNTIL\nstatus.ready]", "SLEEP: ERROR - [=== ERROR\ncontext deadline exceeded]"},
+	}, {
+		name: "until invalid expression",
+		sleep: v1alpha1.Sleep{
+			Duration: metav1.Duration{Duration: time.Minute},
+			Until:    until("status.("),
+		},
+		getFn:       ready(0),
+		maxDuration: time.Second,
+		expectedErr: "failed to evaluate expression status.( (SyntaxError: Expected identifier, lbracket, or lbrace)",
+		expectedLogs: []string{
+			"SLEEP: RUN - [=== UNTIL\nstatus.(]",
+			"SLEEP: ERROR - [=== ERROR\nfailed to evaluate expression status.( (SyntaxError: Expected identifier, lbracket, or lbrace)]",
+		},
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
-			operation := New(
-				tt.sleep,
-			)
+			if tt.cancelled {
+				time.AfterFunc(50*time.Millisecond, cancel)
+			}
+			if tt.deadline != 0 {
+				ctx, cancel = context.WithTimeout(ctx, tt.deadline)
+				defer cancel()
+			}
+			client := &tclient.FakeClient{
+				GetFn: tt.getFn,
+			}
+			interval := &metav1.Duration{Duration: 10 * time.Millisecond}
+			operation := New(client, nil, false, v1alpha1.Polling{Interval: interval}, tt.sleep)
 			logger := &tlogging.FakeLogger{}
-			err := operation.Exec(ttesting.IntoContext(logging.IntoContext(ctx, logger), t))
-			assert.NoError(t, err)
+			start := time.Now()
+			_, err := operation.Exec(ttesting.IntoContext(logging.IntoContext(ctx, logger), t), nil)
+			elapsed := time.Since(start)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.GreaterOrEqual(t, elapsed, tt.minDuration)
+			if tt.maxDuration != 0 {
+				assert.Less(t, elapsed, tt.maxDuration)
+			}
 			assert.Equal(t, tt.expectedLogs, logger.Logs)
 		})
 	}
@@ -1,11 +1,16 @@
 package testing
 
-import "context"
+import (
+	"context"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+)
 
 type MockOperation struct {
-	ExecFn func(ctx context.Context) error
+	ExecFn func(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error)
 }
 
-func (m MockOperation) Exec(ctx context.Context) error {
-	return m.ExecFn(ctx)
+func (m MockOperation) Exec(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error) {
+	return m.ExecFn(ctx, bindings)
 }
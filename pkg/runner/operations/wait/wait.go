@@ -0,0 +1,205 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/client"
+	runnerbindings "github.com/kyverno/chainsaw/pkg/runner/bindings"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+	"github.com/kyverno/chainsaw/pkg/runner/operations/internal"
+	"github.com/kyverno/chainsaw/pkg/runner/template"
+	"github.com/kyverno/kyverno/ext/output/color"
+	"go.uber.org/multierr"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// predicate returns a reason when the resource doesn't satisfy the expected state yet.
+type predicate func(context.Context, binding.Bindings, unstructured.Unstructured) (string, error)
+
+type operation struct {
+	client     client.Client
+	obj        unstructured.Unstructured
+	namespacer namespacer.Namespacer
+	template   bool
+	polling    v1alpha1.Polling
+	waitFor    string
+}
+
+func New(client client.Client, obj unstructured.Unstructured, namespacer namespacer.Namespacer, template bool, polling v1alpha1.Polling, waitFor string) operations.Operation {
+	return &operation{
+		client:     client,
+		obj:        obj,
+		namespacer: namespacer,
+		template:   template,
+		polling:    polling,
+		waitFor:    waitFor,
+	}
+}
+
+func (o *operation) Exec(ctx context.Context, bindings binding.Bindings) (_ operations.Outputs, err error) {
+	logger := internal.GetLogger(ctx, &o.obj)
+	defer func() {
+		internal.LogEnd(logger, logging.Wait, err)
+	}()
+	if o.template {
+		if err := template.Resource(ctx, &o.obj, bindings); err != nil {
+			return nil, err
+		}
+	}
+	if err := internal.ApplyNamespacer(o.namespacer, &o.obj); err != nil {
+		return nil, err
+	}
+	internal.LogStart(logger, logging.Wait, logging.Section("FOR", o.waitFor))
+	return nil, o.execute(ctx, logger, bindings)
+}
+
+func (o *operation) execute(ctx context.Context, logger logging.Logger, bindings binding.Bindings) error {
+	predicate, err := parse(o.waitFor)
+	if err != nil {
+		return err
+	}
+	var lastErrs []error
+	var lastObserved []unstructured.Unstructured
+	err = internal.WaitFor(ctx, &o.obj, o.client, o.polling, false, func(ctx context.Context) (_ bool, err error) {
+		var errs []error
+		defer func() {
+			// record last errors only if there was no real error
+			if err == nil {
+				lastErrs = errs
+			}
+		}()
+		candidates, err := internal.Read(ctx, &o.obj, o.client)
+		if err != nil {
+			if !kerrors.IsNotFound(err) {
+				return false, err
+			}
+			candidates = nil
+		}
+		lastObserved = candidates
+		// waiting for deletion, no predicate to evaluate
+		if predicate == nil {
+			for i := range candidates {
+				errs = append(errs, fmt.Errorf("%s - resource still exists", describe(candidates[i])))
+			}
+			return len(candidates) == 0, nil
+		}
+		if len(candidates) == 0 {
+			errs = append(errs, errors.New("no actual resource found"))
+			return false, nil
+		}
+		for i := range candidates {
+			reason, err := predicate(ctx, bindings, candidates[i])
+			if err != nil {
+				return false, err
+			}
+			if reason != "" {
+				errs = append(errs, fmt.Errorf("%s - %s", describe(candidates[i]), reason))
+			}
+		}
+		return len(errs) == 0, nil
+	})
+	// if no error, return success
+	if err == nil {
+		return nil
+	}
+	// log the last observed state if the operation timed out
+	if ctx.Err() != nil && logger != nil {
+		logger.Log(logging.Wait, logging.LogStatus, color.BoldYellow, logging.Section("LAST OBSERVED STATE", lastState(lastObserved)))
+	}
+	// eventually return a combination of last errors
+	if len(lastErrs) != 0 {
+		return multierr.Combine(lastErrs...)
+	}
+	// return received error
+	return err
+}
+
+func parse(waitFor string) (predicate, error) {
+	switch {
+	case waitFor == v1alpha1.WaitForDelete:
+		return nil, nil
+	case strings.HasPrefix(waitFor, v1alpha1.WaitForConditionPrefix):
+		conditionType, status, _ := strings.Cut(strings.TrimPrefix(waitFor, v1alpha1.WaitForConditionPrefix), "=")
+		if conditionType == "" {
+			return nil, fmt.Errorf("invalid wait condition: %s", waitFor)
+		}
+		if status == "" {
+			status = "True"
+		}
+		return conditionPredicate(conditionType, status), nil
+	case strings.HasPrefix(waitFor, v1alpha1.WaitForJMESPathPrefix):
+		expression := strings.TrimPrefix(waitFor, v1alpha1.WaitForJMESPathPrefix)
+		if expression == "" {
+			return nil, fmt.Errorf("invalid wait expression: %s", waitFor)
+		}
+		return jmespathPredicate(expression), nil
+	default:
+		return nil, fmt.Errorf("invalid wait for: %s (must be delete, condition=<type>[=<status>] or jmespath=<expression>)", waitFor)
+	}
+}
+
+func conditionPredicate(conditionType string, status string) predicate {
+	return func(_ context.Context, _ binding.Bindings, resource unstructured.Unstructured) (string, error) {
+		conditions, _, err := unstructured.NestedSlice(resource.UnstructuredContent(), "status", "conditions")
+		if err != nil {
+			return "", err
+		}
+		for _, condition := range conditions {
+			condition, ok := condition.(map[string]any)
+			if !ok {
+				continue
+			}
+			if actual, _ := condition["type"].(string); !strings.EqualFold(actual, conditionType) {
+				continue
+			}
+			actual, _ := condition["status"].(string)
+			if strings.EqualFold(actual, status) {
+				return "", nil
+			}
+			return fmt.Sprintf("condition %s is %s (expected %s)", conditionType, actual, status), nil
+		}
+		return fmt.Sprintf("condition %s not found", conditionType), nil
+	}
+}
+
+func jmespathPredicate(expression string) predicate {
+	return func(ctx context.Context, bindings binding.Bindings, resource unstructured.Unstructured) (string, error) {
+		result, err := runnerbindings.Execute(ctx, expression, resource.UnstructuredContent(), bindings)
+		if err != nil {
+			return "", fmt.Errorf("failed to evaluate expression %s (%w)", expression, err)
+		}
+		if result != true {
+			return fmt.Sprintf("expression %s evaluated to %v", expression, result), nil
+		}
+		return "", nil
+	}
+}
+
+func describe(resource unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s/%s", resource.GetAPIVersion(), resource.GetKind(), client.Name(client.ObjectKey(&resource)))
+}
+
+func lastState(resources []unstructured.Unstructured) string {
+	if len(resources) == 0 {
+		return "no resource found"
+	}
+	var documents []string
+	for _, resource := range resources {
+		data, err := yaml.Marshal(resource.UnstructuredContent())
+		if err != nil {
+			documents = append(documents, fmt.Sprintf("%s - %s", describe(resource), err))
+		} else {
+			documents = append(documents, strings.TrimSpace(string(data)))
+		}
+	}
+	return strings.Join(documents, "\n---\n")
+}
@@ -0,0 +1,245 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	tclient "github.com/kyverno/chainsaw/pkg/client/testing"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	ttesting "github.com/kyverno/chainsaw/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Test_operationWait(t *testing.T) {
+	pod := unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]any{
+				"name": "test-pod",
+			},
+		},
+	}
+	pods := unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]any{
+				"labels": map[string]any{
+					"app": "chainsaw",
+				},
+			},
+		},
+	}
+	withStatus := func(status string) func(context.Context, int, ctrlclient.ObjectKey, ctrlclient.Object, ...ctrlclient.GetOption) error {
+		return func(_ context.Context, _ int, _ ctrlclient.ObjectKey, obj ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+			obj.(*unstructured.Unstructured).Object = map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata": map[string]any{
+					"name": "test-pod",
+				},
+				"status": map[string]any{
+					"phase": "Running",
+					"conditions": []any{
+						map[string]any{
+							"type":   "Ready",
+							"status": status,
+						},
+					},
+				},
+			}
+			return nil
+		}
+	}
+	notFound := func(_ context.Context, _ int, key ctrlclient.ObjectKey, obj ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+		return kerrors.NewNotFound(obj.GetObjectKind().GroupVersionKind().GroupVersion().WithResource("pod").GroupResource(), key.Name)
+	}
+	tests := []struct {
+		name         string
+		object       unstructured.Unstructured
+		waitFor      string
+		client       *tclient.FakeClient
+		expectedErr  error
+		expectedLogs []string
+	}{{
+		name:    "condition met",
+		object:  pod,
+		waitFor: "condition=Ready",
+		client: &tclient.FakeClient{
+			GetFn: withStatus("True"),
+		},
+		expectedLogs: []string{"WAIT: RUN - [=== FOR\ncondition=Ready]", "WAIT: DONE - []"},
+	}, {
+		name:    "condition met eventually",
+		object:  pod,
+		waitFor: "condition=ready",
+		client: &tclient.FakeClient{
+			GetFn: func(ctx context.Context, call int, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+				if call < 2 {
+					return notFound(ctx, call, key, obj, opts...)
+				}
+				return withStatus("true")(ctx, call, key, obj, opts...)
+			},
+		},
+		expectedLogs: []string{"WAIT: RUN - [=== FOR\ncondition=ready]", "WAIT: DONE - []"},
+	}, {
+		name:    "condition with status",
+		object:  pod,
+		waitFor: "condition=Ready=False",
+		client: &tclient.FakeClient{
+			GetFn: withStatus("False"),
+		},
+		expectedLogs: []string{"WAIT: RUN - [=== FOR\ncondition=Ready=False]", "WAIT: DONE - []"},
+	}, {
+		name:    "condition not met",
+		object:  pod,
+		waitFor: "condition=Ready",
+		client: &tclient.FakeClient{
+			GetFn: withStatus("False"),
+		},
+		expectedErr: errors.New("v1/Pod/test-pod - condition Ready is False (expected True)"),
+		expectedLogs: []string{
+			"WAIT: RUN - [=== FOR\ncondition=Ready]",
+			"WAIT: LOG - [=== LAST OBSERVED STATE\napiVersion: v1\nkind: Pod\nmetadata:\n  name: test-pod\nstatus:\n  conditions:\n  - status: \"False\"\n    type: Ready\n  phase: Running]",
+			"WAIT: ERROR - [=== ERROR\nv1/Pod/test-pod - condition Ready is False (expected True)]",
+		},
+	}, {
+		name:    "condition not found",
+		object:  pod,
+		waitFor: "condition=Initialized",
+		client: &tclient.FakeClient{
+			GetFn: withStatus("True"),
+		},
+		expectedErr: errors.New("v1/Pod/test-pod - condition Initialized not found"),
+		expectedLogs: []string{
+			"WAIT: RUN - [=== FOR\ncondition=Initialized]",
+			"WAIT: LOG - [=== LAST OBSERVED STATE\napiVersion: v1\nkind: Pod\nmetadata:\n  name: test-pod\nstatus:\n  conditions:\n  - status: \"True\"\n    type: Ready\n  phase: Running]",
+			"WAIT: ERROR - [=== ERROR\nv1/Pod/test-pod - condition Initialized not found]",
+		},
+	}, {
+		name:    "no resource matching labels",
+		object:  pods,
+		waitFor: "condition=Ready",
+		client: &tclient.FakeClient{
+			ListFn: func(_ context.Context, _ int, _ ctrlclient.ObjectList, _ ...ctrlclient.ListOption) error {
+				return nil
+			},
+		},
+		expectedErr: errors.New("no actual resource found"),
+		expectedLogs: []string{
+			"WAIT: RUN - [=== FOR\ncondition=Ready]",
+			"WAIT: LOG - [=== LAST OBSERVED STATE\nno resource found]",
+			"WAIT: ERROR - [=== ERROR\nno actual resource found]",
+		},
+	}, {
+		name:    "deleted",
+		object:  pod,
+		waitFor: "delete",
+		client: &tclient.FakeClient{
+			GetFn: notFound,
+		},
+		expectedLogs: []string{"WAIT: RUN - [=== FOR\ndelete]", "WAIT: DONE - []"},
+	}, {
+		name:    "deleted eventually",
+		object:  pod,
+		waitFor: "delete",
+		client: &tclient.FakeClient{
+			GetFn: func(ctx context.Context, call int, key ctrlclient.ObjectKey, obj ctrlclient.Object, opts ...ctrlclient.GetOption) error {
+				if call < 2 {
+					return withStatus("True")(ctx, call, key, obj, opts...)
+				}
+				return notFound(ctx, call, key, obj, opts...)
+			},
+		},
+		expectedLogs: []string{"WAIT: RUN - [=== FOR\ndelete]", "WAIT: DONE - []"},
+	}, {
+		name:    "not deleted",
+		object:  pod,
+		waitFor: "delete",
+		client: &tclient.FakeClient{
+			GetFn: func(_ context.Context, _ int, _ ctrlclient.ObjectKey, obj ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+				obj.(*unstructured.Unstructured).Object = pod.DeepCopy().Object
+				return nil
+			},
+		},
+		expectedErr: errors.New("v1/Pod/test-pod - resource still exists"),
+		expectedLogs: []string{
+			"WAIT: RUN - [=== FOR\ndelete]",
+			"WAIT: LOG - [=== LAST OBSERVED STATE\napiVersion: v1\nkind: Pod\nmetadata:\n  name: test-pod]",
+			"WAIT: ERROR - [=== ERROR\nv1/Pod/test-pod - resource still exists]",
+		},
+	}, {
+		name:    "expression met",
+		object:  pod,
+		waitFor: "jmespath=status.phase == 'Running'",
+		client: &tclient.FakeClient{
+			GetFn: withStatus("False"),
+		},
+		expectedLogs: []string{"WAIT: RUN - [=== FOR\njmespath=status.phase == 'Running']", "WAIT: DONE - []"},
+	}, {
+		name:    "expression not met",
+		object:  pod,
+		waitFor: "jmespath=status.phase == 'Succeeded'",
+		client: &tclient.FakeClient{
+			GetFn: withStatus("False"),
+		},
+		expectedErr: errors.New("v1/Pod/test-pod - expression status.phase == 'Succeeded' evaluated to false"),
+		expectedLogs: []string{
+			"WAIT: RUN - [=== FOR\njmespath=status.phase == 'Succeeded']",
+			"WAIT: LOG - [=== LAST OBSERVED STATE\napiVersion: v1\nkind: Pod\nmetadata:\n  name: test-pod\nstatus:\n  conditions:\n  - status: \"False\"\n    type: Ready\n  phase: Running]",
+			"WAIT: ERROR - [=== ERROR\nv1/Pod/test-pod - expression status.phase == 'Succeeded' evaluated to false]",
+		},
+	}, {
+		name:    "invalid expression",
+		object:  pod,
+		waitFor: "jmespath=status.[",
+		client: &tclient.FakeClient{
+			GetFn: withStatus("False"),
+		},
+		expectedErr: errors.New("failed to evaluate expression status.[ (SyntaxError: Incomplete expression)"),
+		expectedLogs: []string{
+			"WAIT: RUN - [=== FOR\njmespath=status.[]",
+			"WAIT: ERROR - [=== ERROR\nfailed to evaluate expression status.[ (SyntaxError: Incomplete expression)]",
+		},
+	}, {
+		name:        "invalid for",
+		object:      pod,
+		waitFor:     "ready",
+		client:      &tclient.FakeClient{},
+		expectedErr: errors.New("invalid wait for: ready (must be delete, condition=<type>[=<status>] or jmespath=<expression>)"),
+		expectedLogs: []string{
+			"WAIT: RUN - [=== FOR\nready]",
+			"WAIT: ERROR - [=== ERROR\ninvalid wait for: ready (must be delete, condition=<type>[=<status>] or jmespath=<expression>)]",
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+			operation := New(
+				tt.client,
+				tt.object,
+				nil,
+				false,
+				v1alpha1.Polling{},
+				tt.waitFor,
+			)
+			logger := &tlogging.FakeLogger{}
+			_, err := operation.Exec(ttesting.IntoContext(logging.IntoContext(ctx, logger), t), nil)
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedLogs, logger.Logs)
+		})
+	}
+}
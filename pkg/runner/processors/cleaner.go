@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/client"
 	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
 	opdelete "github.com/kyverno/chainsaw/pkg/runner/operations/delete"
@@ -15,6 +16,7 @@ type cleaner struct {
 	namespacer namespacer.Namespacer
 	delay      *metav1.Duration
 	operations []operation
+	objects    []unstructured.Unstructured
 }
 
 func newCleaner(namespacer namespacer.Namespacer, delay *metav1.Duration) *cleaner {
@@ -24,19 +26,31 @@ func newCleaner(namespacer namespacer.Namespacer, delay *metav1.Duration) *clean
 	}
 }
 
-func (c *cleaner) register(obj unstructured.Unstructured, client client.Client, timeout *time.Duration) {
+func (c *cleaner) register(obj unstructured.Unstructured, client client.Client, timeout *time.Duration, polling v1alpha1.Polling) {
+	c.objects = append(c.objects, obj)
 	c.operations = append(c.operations, operation{
 		continueOnError: true,
 		timeout:         timeout,
-		operation:       opdelete.New(client, obj, c.namespacer),
+		operation:       opdelete.New(client, obj, c.namespacer, false, polling),
 	})
 }
 
+// clusterScoped returns the registered objects that don't belong to a namespace.
+func (c *cleaner) clusterScoped() []unstructured.Unstructured {
+	var objects []unstructured.Unstructured
+	for _, obj := range c.objects {
+		if obj.GetNamespace() == "" {
+			objects = append(objects, obj)
+		}
+	}
+	return objects
+}
+
 func (c *cleaner) run(ctx context.Context) {
 	if c.delay != nil {
 		time.Sleep(c.delay.Duration)
 	}
 	for i := len(c.operations) - 1; i >= 0; i-- {
-		c.operations[i].execute(ctx)
+		c.operations[i].execute(ctx, nil)
 	}
 }
@@ -5,8 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	fake "github.com/kyverno/chainsaw/pkg/client/testing"
 	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
 	mock "github.com/kyverno/chainsaw/pkg/runner/operations/testing"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -42,7 +45,7 @@ func Test_Cleaner_Register(t *testing.T) {
 			c := newCleaner(fakeNamespacer, nil)
 			for i := 0; i < tc.expectedOp; i++ {
 				localTimeout := tc.timeout
-				c.register(mockObj, fakeClient, &localTimeout)
+				c.register(mockObj, fakeClient, &localTimeout, v1alpha1.Polling{})
 			}
 
 			assert.Len(t, c.operations, tc.expectedOp)
@@ -54,6 +57,22 @@ func Test_Cleaner_Register(t *testing.T) {
 	}
 }
 
+func Test_Cleaner_ClusterScoped(t *testing.T) {
+	fakeClient := &fake.FakeClient{}
+	c := newCleaner(namespacer.New(fakeClient, "default"), nil)
+	var namespaced unstructured.Unstructured
+	namespaced.SetKind("ConfigMap")
+	namespaced.SetName("foo")
+	namespaced.SetNamespace("default")
+	var clusterScoped unstructured.Unstructured
+	clusterScoped.SetKind("ClusterRole")
+	clusterScoped.SetName("bar")
+	c.register(namespaced, fakeClient, nil, v1alpha1.Polling{})
+	c.register(clusterScoped, fakeClient, nil, v1alpha1.Polling{})
+	assert.Len(t, c.objects, 2)
+	assert.Equal(t, []unstructured.Unstructured{clusterScoped}, c.clusterScoped())
+}
+
 func Test_Cleaner_Run(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -71,8 +90,8 @@ func Test_Cleaner_Run(t *testing.T) {
 					continueOnError: true,
 					timeout:         nil,
 					operation: mock.MockOperation{
-						ExecFn: func(ctx context.Context) error {
-							return nil
+						ExecFn: func(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error) {
+							return nil, nil
 						},
 					},
 					operationReport: nil,
@@ -0,0 +1,55 @@
+package processors
+
+import (
+	"errors"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
+	"github.com/kyverno/chainsaw/pkg/runner/operations"
+	opdescribe "github.com/kyverno/chainsaw/pkg/runner/operations/describe"
+	opdump "github.com/kyverno/chainsaw/pkg/runner/operations/dump"
+	opevents "github.com/kyverno/chainsaw/pkg/runner/operations/events"
+	oppodlogs "github.com/kyverno/chainsaw/pkg/runner/operations/podlogs"
+	"github.com/kyverno/chainsaw/pkg/runner/timeout"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+)
+
+// dumpOperations returns the operations collecting the cluster state when a test fails.
+func (p *testProcessor) dumpOperations(nspacer namespacer.Namespacer, cleaner *cleaner, dump v1alpha1.Dump) ([]operation, error) {
+	if p.cfg == nil {
+		return nil, errors.New("cluster state can't be dumped without a rest config")
+	}
+	var namespace string
+	if nspacer != nil {
+		namespace = nspacer.GetNamespace()
+	}
+	tail := dump.Tail
+	if tail == nil {
+		tail = ptr.To(-1)
+	}
+	collectors := []operations.Operation{
+		opdump.New(p.client, p.clientset.Discovery(), namespace, cleaner.clusterScoped()...),
+	}
+	if namespace != "" {
+		var pod unstructured.Unstructured
+		pod.SetAPIVersion("v1")
+		pod.SetKind("Pod")
+		pod.SetNamespace(namespace)
+		collectors = append(
+			collectors,
+			opevents.New(p.client, v1alpha1.Events{}, namespace),
+			oppodlogs.New(p.clientset, v1alpha1.PodLogs{Tail: tail}, namespace),
+			opdescribe.New(p.client, pod, nspacer),
+		)
+	}
+	var ops []operation
+	for _, collector := range collectors {
+		ops = append(ops, operation{
+			continueOnError: true,
+			timeout:         timeout.Get(dump.Timeout, p.timeouts.ExecDuration()),
+			operation:       collector,
+		})
+	}
+	return ops, nil
+}
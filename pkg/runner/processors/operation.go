@@ -4,7 +4,12 @@ import (
 	"context"
 	"time"
 
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/report"
+	"github.com/kyverno/chainsaw/pkg/runner/artifacts"
+	runnerbindings "github.com/kyverno/chainsaw/pkg/runner/bindings"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
 	"github.com/kyverno/chainsaw/pkg/runner/operations"
 	"github.com/kyverno/chainsaw/pkg/testing"
 )
@@ -12,20 +17,24 @@ import (
 type operation struct {
 	continueOnError bool
 	timeout         *time.Duration
+	bindings        []v1alpha1.Binding
 	operation       operations.Operation
 	operationReport *report.OperationReport
 }
 
-func (o operation) execute(ctx context.Context) {
+func (o operation) execute(ctx context.Context, bindings binding.Bindings) operations.Outputs {
+	if o.operationReport != nil {
+		o.operationReport.MarkOperationStart()
+	}
 	if o.timeout != nil {
 		toCtx, cancel := context.WithTimeout(ctx, *o.timeout)
 		ctx = toCtx
 		defer cancel()
 	}
-	if err := o.operation.Exec(ctx); err != nil {
+	handleError := func(err error) {
 		t := testing.FromContext(ctx)
 		if o.operationReport != nil {
-			o.operationReport.MarkOperationEnd(false, err.Error())
+			o.operationReport.MarkOperationError(err)
 		}
 		if o.continueOnError {
 			t.Fail()
@@ -33,7 +42,32 @@ func (o operation) execute(ctx context.Context) {
 			t.FailNow()
 		}
 	}
+	bindings, err := runnerbindings.RegisterBindings(ctx, bindings, nil, o.bindings...)
+	if err != nil {
+		handleError(err)
+		return nil
+	}
+	if o.operationReport != nil {
+		recorder := logging.NewRecorder(logging.FromContext(ctx))
+		ctx = logging.IntoContext(ctx, recorder)
+		defer func() {
+			o.operationReport.AddOutput(recorder.Sections()...)
+		}()
+	}
+	if store := artifacts.FromContext(ctx); store != nil && o.operationReport != nil {
+		recorder := artifacts.NewRecorder(store)
+		ctx = artifacts.IntoContext(ctx, recorder)
+		defer func() {
+			o.operationReport.AddArtifacts(recorder.Paths()...)
+		}()
+	}
+	outputs, err := o.operation.Exec(ctx, bindings)
+	if err != nil {
+		handleError(err)
+		return nil
+	}
 	if o.operationReport != nil {
 		o.operationReport.MarkOperationEnd(true, "Operation completed successfully")
 	}
+	return outputs
 }
@@ -3,9 +3,15 @@ package processors
 import (
 	"context"
 	"errors"
+	"path/filepath"
 	"time"
 
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/report"
+	"github.com/kyverno/chainsaw/pkg/runner/artifacts"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
 	"github.com/kyverno/chainsaw/pkg/runner/operations"
 	mock "github.com/kyverno/chainsaw/pkg/runner/operations/testing"
 	"github.com/kyverno/chainsaw/pkg/testing"
@@ -24,8 +30,8 @@ func TestOperation_Execute(t *testing.T) {
 		{
 			name: "operation fails but continues",
 			operation: mock.MockOperation{
-				ExecFn: func(ctx context.Context) error {
-					return errors.New("operation failed")
+				ExecFn: func(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error) {
+					return nil, errors.New("operation failed")
 				},
 			},
 			continueOnError: true,
@@ -36,8 +42,8 @@ func TestOperation_Execute(t *testing.T) {
 		{
 			name: "operation fails and don't continues",
 			operation: mock.MockOperation{
-				ExecFn: func(ctx context.Context) error {
-					return errors.New("operation failed")
+				ExecFn: func(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error) {
+					return nil, errors.New("operation failed")
 				},
 			},
 			continueOnError: false,
@@ -47,8 +53,8 @@ func TestOperation_Execute(t *testing.T) {
 		{
 			name: "operation succeeds",
 			operation: mock.MockOperation{
-				ExecFn: func(ctx context.Context) error {
-					return nil
+				ExecFn: func(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error) {
+					return nil, nil
 				},
 			},
 			expectedFail:    false,
@@ -68,7 +74,7 @@ func TestOperation_Execute(t *testing.T) {
 			}
 			nt := testing.MockT{}
 			ctx := testing.IntoContext(context.Background(), &nt)
-			op.execute(ctx)
+			op.execute(ctx, nil)
 
 			if localTC.expectedFail {
 				assert.True(t, nt.FailedVar, "expected an error but got none")
@@ -78,3 +84,71 @@ func TestOperation_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestOperation_ExecuteBindings(t *testing.T) {
+	var received any
+	op := operation{
+		bindings: []v1alpha1.Binding{{
+			Name:  "foo",
+			Value: v1alpha1.Check{Value: "(join('-', [$bar, 'foo']))"},
+		}},
+		operation: mock.MockOperation{
+			ExecFn: func(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error) {
+				foo, err := bindings.Get("$foo")
+				if err != nil {
+					return nil, err
+				}
+				received, err = foo.Value()
+				if err != nil {
+					return nil, err
+				}
+				return operations.Outputs{"baz": received}, nil
+			},
+		},
+	}
+	nt := testing.MockT{}
+	ctx := testing.IntoContext(context.Background(), &nt)
+	outputs := op.execute(ctx, binding.NewBindings().Register("$bar", binding.NewBinding("bar")))
+	assert.False(t, nt.FailedVar)
+	assert.Equal(t, "bar-foo", received)
+	assert.Equal(t, operations.Outputs{"baz": "bar-foo"}, outputs)
+}
+
+func TestOperation_ExecuteArtifacts(t *testing.T) {
+	dir := t.TempDir()
+	operationReport := report.NewOperation("FakeOperation", report.OperationTypeCommand)
+	op := operation{
+		operation: mock.MockOperation{
+			ExecFn: func(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error) {
+				return nil, artifacts.Write(ctx, "command-stdout.log", []byte("foo"))
+			},
+		},
+		operationReport: operationReport,
+	}
+	nt := testing.MockT{}
+	ctx := testing.IntoContext(context.Background(), &nt)
+	op.execute(artifacts.IntoContext(ctx, artifacts.New(dir)), nil)
+	assert.False(t, nt.FailedVar)
+	assert.Equal(t, []string{filepath.Join(dir, "01-command-stdout.log")}, operationReport.Artifacts)
+}
+
+func TestOperation_ExecuteOutput(t *testing.T) {
+	logger := &tlogging.FakeLogger{}
+	operationReport := report.NewOperation("FakeOperation", report.OperationTypeCommand)
+	op := operation{
+		operation: mock.MockOperation{
+			ExecFn: func(ctx context.Context, bindings binding.Bindings) (operations.Outputs, error) {
+				logging.Log(ctx, logging.Command, logging.LogStatus, nil, logging.Section("STDOUT", "foo"))
+				return nil, errors.New("command failed")
+			},
+		},
+		continueOnError: true,
+		operationReport: operationReport,
+	}
+	nt := testing.MockT{}
+	ctx := testing.IntoContext(context.Background(), &nt)
+	op.execute(logging.IntoContext(ctx, logger), nil)
+	assert.True(t, nt.FailedVar)
+	assert.Equal(t, []string{"=== STDOUT\nfoo"}, operationReport.Output)
+	assert.Equal(t, []string{"CMD: LOG - [=== STDOUT\nfoo]"}, logger.Logs)
+}
@@ -0,0 +1,32 @@
+package processors
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"strconv"
+)
+
+// shuffleSeed returns the seed used to shuffle tests, ok is false when shuffling is disabled.
+func shuffleSeed(shuffle string) (seed int64, ok bool) {
+	seed, err := strconv.ParseInt(shuffle, 10, 64)
+	return seed, err == nil
+}
+
+// stepsSeed derives the seed used to shuffle the steps of a test, it doesn't depend on the order tests run.
+func stepsSeed(seed int64, test string) int64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(test))
+	return seed ^ int64(hash.Sum64())
+}
+
+// shuffledIndices returns the indices from 0 to n-1 in a random order derived from the seed.
+func shuffledIndices(n int, seed int64) []int {
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	rand.New(rand.NewSource(seed)).Shuffle(n, func(i, j int) { //nolint:gosec
+		indices[i], indices[j] = indices[j], indices[i]
+	})
+	return indices
+}
@@ -0,0 +1,112 @@
+package processors
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/discovery"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_shuffleSeed(t *testing.T) {
+	tests := []struct {
+		shuffle string
+		want    int64
+		wantOk  bool
+	}{{
+		shuffle: "",
+	}, {
+		shuffle: "off",
+	}, {
+		shuffle: "on",
+	}, {
+		shuffle: "42",
+		want:    42,
+		wantOk:  true,
+	}, {
+		shuffle: "-1",
+		want:    -1,
+		wantOk:  true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.shuffle, func(t *testing.T) {
+			got, ok := shuffleSeed(tt.shuffle)
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_shuffledIndices(t *testing.T) {
+	indices := shuffledIndices(20, 42)
+	// the same seed gives the same order
+	assert.Equal(t, indices, shuffledIndices(20, 42))
+	assert.NotEqual(t, indices, shuffledIndices(20, 43))
+	// all indices are present exactly once
+	sorted := append([]int(nil), indices...)
+	sort.Ints(sorted)
+	for i := range sorted {
+		assert.Equal(t, i, sorted[i])
+	}
+	assert.Empty(t, shuffledIndices(0, 42))
+}
+
+func Test_stepsSeed(t *testing.T) {
+	assert.Equal(t, stepsSeed(42, "foo"), stepsSeed(42, "foo"))
+	assert.NotEqual(t, stepsSeed(42, "foo"), stepsSeed(42, "bar"))
+	assert.NotEqual(t, stepsSeed(42, "foo"), stepsSeed(43, "foo"))
+}
+
+func Test_testProcessor_stepsOrder(t *testing.T) {
+	steps := make([]v1alpha1.TestSpecStep, 10)
+	identity := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	tests := []struct {
+		name         string
+		shuffle      string
+		shuffleSteps bool
+		shuffled     bool
+	}{{
+		name:         "shuffle disabled",
+		shuffle:      "off",
+		shuffleSteps: true,
+	}, {
+		name:    "steps shuffle not allowed",
+		shuffle: "42",
+	}, {
+		name:         "steps shuffle allowed",
+		shuffle:      "42",
+		shuffleSteps: true,
+		shuffled:     true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testProcessor{
+				config: v1alpha1.ConfigurationSpec{
+					Shuffle: tt.shuffle,
+				},
+				test: discovery.Test{
+					Test: &v1alpha1.Test{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "test",
+						},
+						Spec: v1alpha1.TestSpec{
+							ShuffleSteps: tt.shuffleSteps,
+							Steps:        steps,
+						},
+					},
+				},
+			}
+			got := p.stepsOrder()
+			if tt.shuffled {
+				assert.NotEqual(t, identity, got)
+				assert.Equal(t, shuffledIndices(len(steps), stepsSeed(42, "test")), got)
+			} else {
+				assert.Equal(t, identity, got)
+			}
+		})
+	}
+}
@@ -2,17 +2,20 @@ package processors
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/url"
 	"path/filepath"
 
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/client"
 	"github.com/kyverno/chainsaw/pkg/discovery"
 	"github.com/kyverno/chainsaw/pkg/report"
 	"github.com/kyverno/chainsaw/pkg/resource"
+	runnerbindings "github.com/kyverno/chainsaw/pkg/runner/bindings"
 	"github.com/kyverno/chainsaw/pkg/runner/cleanup"
-	"github.com/kyverno/chainsaw/pkg/runner/collect"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
 	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
 	opapply "github.com/kyverno/chainsaw/pkg/runner/operations/apply"
@@ -20,13 +23,24 @@ import (
 	opcommand "github.com/kyverno/chainsaw/pkg/runner/operations/command"
 	opcreate "github.com/kyverno/chainsaw/pkg/runner/operations/create"
 	opdelete "github.com/kyverno/chainsaw/pkg/runner/operations/delete"
+	opdescribe "github.com/kyverno/chainsaw/pkg/runner/operations/describe"
 	operror "github.com/kyverno/chainsaw/pkg/runner/operations/error"
+	opevents "github.com/kyverno/chainsaw/pkg/runner/operations/events"
+	opexec "github.com/kyverno/chainsaw/pkg/runner/operations/exec"
+	opget "github.com/kyverno/chainsaw/pkg/runner/operations/get"
+	ophttp "github.com/kyverno/chainsaw/pkg/runner/operations/http"
+	oppatch "github.com/kyverno/chainsaw/pkg/runner/operations/patch"
+	oppodlogs "github.com/kyverno/chainsaw/pkg/runner/operations/podlogs"
 	opscript "github.com/kyverno/chainsaw/pkg/runner/operations/script"
 	opsleep "github.com/kyverno/chainsaw/pkg/runner/operations/sleep"
+	opwait "github.com/kyverno/chainsaw/pkg/runner/operations/wait"
 	"github.com/kyverno/chainsaw/pkg/runner/timeout"
 	"github.com/kyverno/chainsaw/pkg/testing"
 	"github.com/kyverno/kyverno/ext/output/color"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/utils/clock"
 )
 
@@ -34,12 +48,14 @@ import (
 // - create if not exists
 
 type StepProcessor interface {
-	Run(ctx context.Context)
+	Run(ctx context.Context, bindings binding.Bindings) binding.Bindings
 }
 
 func NewStepProcessor(
 	config v1alpha1.ConfigurationSpec,
 	client client.Client,
+	cfg *rest.Config,
+	clientset kubernetes.Interface,
 	namespacer namespacer.Namespacer,
 	clock clock.PassiveClock,
 	test discovery.Test,
@@ -50,12 +66,15 @@ func NewStepProcessor(
 	return &stepProcessor{
 		config:     config,
 		client:     client,
+		cfg:        cfg,
+		clientset:  clientset,
 		namespacer: namespacer,
 		clock:      clock,
 		test:       test,
 		step:       step,
 		stepReport: stepReport,
 		timeouts:   config.Timeouts.Combine(test.Spec.Timeouts).Combine(step.Timeouts),
+		polling:    v1alpha1.Polling{}.Combine(config.Polling).Combine(test.Spec.Polling).Combine(step.Polling),
 		cleaner:    cleaner,
 	}
 }
@@ -63,18 +82,26 @@ func NewStepProcessor(
 type stepProcessor struct {
 	config     v1alpha1.ConfigurationSpec
 	client     client.Client
+	cfg        *rest.Config
+	clientset  kubernetes.Interface
 	namespacer namespacer.Namespacer
 	clock      clock.PassiveClock
 	test       discovery.Test
 	step       v1alpha1.TestSpecStep
 	stepReport *report.TestSpecStepReport
 	timeouts   v1alpha1.Timeouts
+	polling    v1alpha1.Polling
 	cleaner    *cleaner
 }
 
-func (p *stepProcessor) Run(ctx context.Context) {
+func (p *stepProcessor) Run(ctx context.Context, bindings binding.Bindings) binding.Bindings {
 	t := testing.FromContext(ctx)
 	logger := logging.FromContext(ctx)
+	bindings, err := runnerbindings.RegisterBindings(ctx, bindings, nil, p.step.TestStepSpec.Bindings...)
+	if err != nil {
+		logger.Log(logging.Bindings, logging.ErrorStatus, color.BoldRed, logging.ErrSection(err))
+		t.FailNow()
+	}
 	try, err := p.tryOperations(ctx, p.step.TestStepSpec.Try...)
 	if err != nil {
 		logger.Log(logging.Try, logging.ErrorStatus, color.BoldRed, logging.ErrSection(err))
@@ -99,7 +126,10 @@ func (p *stepProcessor) Run(ctx context.Context) {
 						logger.Log(logging.Catch, logging.DoneStatus, color.BoldFgCyan)
 					}()
 					for _, operation := range catch {
-						operation.execute(ctx)
+						if p.stepReport != nil && operation.operationReport != nil {
+							p.stepReport.AddCatch(operation.operationReport)
+						}
+						operation.execute(ctx, bindings)
 					}
 				})
 			}
@@ -113,7 +143,10 @@ func (p *stepProcessor) Run(ctx context.Context) {
 					logger.Log(logging.Finally, logging.DoneStatus, color.BoldFgCyan)
 				}()
 				for _, operation := range finally {
-					operation.execute(ctx)
+					if p.stepReport != nil && operation.operationReport != nil {
+						p.stepReport.AddFinally(operation.operationReport)
+					}
+					operation.execute(ctx, bindings)
 				}
 			})
 		}()
@@ -123,8 +156,14 @@ func (p *stepProcessor) Run(ctx context.Context) {
 		logger.Log(logging.Try, logging.DoneStatus, color.BoldFgCyan)
 	}()
 	for _, operation := range try {
-		operation.execute(ctx)
+		if p.stepReport != nil && operation.operationReport != nil {
+			p.stepReport.AddOperation(operation.operationReport)
+		}
+		for name, value := range operation.execute(ctx, bindings) {
+			bindings = runnerbindings.RegisterNamedBinding(bindings, name, value)
+		}
 	}
+	return bindings
 }
 
 func (p *stepProcessor) tryOperations(ctx context.Context, handlers ...v1alpha1.Operation) ([]operation, error) {
@@ -134,6 +173,7 @@ func (p *stepProcessor) tryOperations(ctx context.Context, handlers ...v1alpha1.
 			continueOnError := handler.ContinueOnError != nil && *handler.ContinueOnError
 			for _, o := range o {
 				o.continueOnError = continueOnError
+				o.bindings = handler.Bindings
 				ops = append(ops, o)
 			}
 		}
@@ -169,10 +209,30 @@ func (p *stepProcessor) tryOperations(ctx context.Context, handlers ...v1alpha1.
 				return nil, err
 			}
 			register(loaded...)
+		} else if handler.Exec != nil {
+			loaded, err := p.execOperation(ctx, *handler.Exec)
+			if err != nil {
+				return nil, err
+			}
+			register(*loaded)
+		} else if handler.HTTP != nil {
+			loaded, err := p.httpOperation(ctx, *handler.HTTP)
+			if err != nil {
+				return nil, err
+			}
+			register(*loaded)
+		} else if handler.Patch != nil {
+			loaded, err := p.patchOperation(ctx, *handler.Patch)
+			if err != nil {
+				return nil, err
+			}
+			register(*loaded)
 		} else if handler.Script != nil {
 			register(p.scriptOperation(ctx, *handler.Script))
 		} else if handler.Sleep != nil {
 			register(p.sleepOperation(ctx, *handler.Sleep))
+		} else if handler.Wait != nil {
+			register(p.waitOperation(ctx, *handler.Wait))
 		} else {
 			return nil, errors.New("no operation found")
 		}
@@ -190,17 +250,17 @@ func (p *stepProcessor) catchOperations(ctx context.Context, handlers ...v1alpha
 	}
 	for _, handler := range handlers {
 		if handler.PodLogs != nil {
-			cmd, err := collect.PodLogs(handler.PodLogs)
+			loaded, err := p.podLogsOperation(ctx, *handler.PodLogs)
 			if err != nil {
 				return nil, err
 			}
-			register(p.commandOperation(ctx, *cmd))
+			register(*loaded)
 		} else if handler.Events != nil {
-			cmd, err := collect.Events(handler.Events)
-			if err != nil {
-				return nil, err
-			}
-			register(p.commandOperation(ctx, *cmd))
+			register(p.eventsOperation(ctx, *handler.Events))
+		} else if handler.Get != nil {
+			register(p.getOperation(ctx, *handler.Get))
+		} else if handler.Describe != nil {
+			register(p.describeOperation(ctx, *handler.Describe))
 		} else if handler.Command != nil {
 			register(p.commandOperation(ctx, *handler.Command))
 		} else if handler.Script != nil {
@@ -224,17 +284,17 @@ func (p *stepProcessor) finallyOperations(ctx context.Context, handlers ...v1alp
 	}
 	for _, handler := range handlers {
 		if handler.PodLogs != nil {
-			cmd, err := collect.PodLogs(handler.PodLogs)
+			loaded, err := p.podLogsOperation(ctx, *handler.PodLogs)
 			if err != nil {
 				return nil, err
 			}
-			register(p.commandOperation(ctx, *cmd))
+			register(*loaded)
 		} else if handler.Events != nil {
-			cmd, err := collect.Events(handler.Events)
-			if err != nil {
-				return nil, err
-			}
-			register(p.commandOperation(ctx, *cmd))
+			register(p.eventsOperation(ctx, *handler.Events))
+		} else if handler.Get != nil {
+			register(p.getOperation(ctx, *handler.Get))
+		} else if handler.Describe != nil {
+			register(p.describeOperation(ctx, *handler.Describe))
 		} else if handler.Command != nil {
 			register(p.commandOperation(ctx, *handler.Command))
 		} else if handler.Script != nil {
@@ -254,18 +314,30 @@ func (p *stepProcessor) applyOperation(ctx context.Context, op v1alpha1.Apply) (
 		return nil, err
 	}
 	var ops []operation
-	operationReport := report.NewOperation("Apply "+op.File, report.OperationTypeApply)
-	if p.stepReport != nil {
-		p.stepReport.AddOperation(operationReport)
-	}
 	dryRun := op.DryRun != nil && *op.DryRun
+	serverSide := p.config.ServerSideApply
+	if op.ServerSide != nil {
+		serverSide = *op.ServerSide
+	}
+	fieldManager := p.config.FieldManager
+	if op.FieldManager != "" {
+		fieldManager = op.FieldManager
+	}
+	if fieldManager == "" {
+		fieldManager = v1alpha1.DefaultFieldManager
+	}
+	forceConflicts := p.config.ForceConflicts
+	if op.ForceConflicts != nil {
+		forceConflicts = *op.ForceConflicts
+	}
 	for _, resource := range resources {
 		if err := p.prepareResource(resource); err != nil {
 			return nil, err
 		}
 		ops = append(ops, operation{
-			timeout:   timeout.Get(op.Timeout, p.timeouts.ApplyDuration()),
-			operation: opapply.New(p.getClient(dryRun), resource, p.namespacer, p.getCleaner(ctx, dryRun), op.Expect...),
+			timeout:         timeout.Get(op.Timeout, p.timeouts.ApplyDuration()),
+			operation:       opapply.New(p.getClient(dryRun), resource, p.namespacer, p.getCleaner(ctx, dryRun), p.getTemplate(op.Template), p.polling.Combine(op.Polling), serverSide, fieldManager, forceConflicts, op.Outputs, op.Expect...),
+			operationReport: newOperationReport("Apply", report.OperationTypeApply, &resource),
 		})
 	}
 	return ops, nil
@@ -277,29 +349,21 @@ func (p *stepProcessor) assertOperation(ctx context.Context, op v1alpha1.Assert)
 		return nil, err
 	}
 	var ops []operation
-	operationReport := report.NewOperation("Assert ", report.OperationTypeAssert)
-	if p.stepReport != nil {
-		p.stepReport.AddOperation(operationReport)
-	}
 	for _, resource := range resources {
 		ops = append(ops, operation{
 			timeout:         timeout.Get(op.Timeout, p.timeouts.AssertDuration()),
-			operation:       opassert.New(p.client, resource, p.namespacer),
-			operationReport: operationReport,
+			operation:       opassert.New(p.client, resource, p.namespacer, p.getTemplate(op.Template), p.polling.Combine(op.Polling), op.Outputs),
+			operationReport: newOperationReport("Assert", report.OperationTypeAssert, &resource),
 		})
 	}
 	return ops, nil
 }
 
 func (p *stepProcessor) commandOperation(ctx context.Context, op v1alpha1.Command) operation {
-	operationReport := report.NewOperation("Command ", report.OperationTypeCommand)
-	if p.stepReport != nil {
-		p.stepReport.AddOperation(operationReport)
-	}
 	return operation{
 		timeout:         timeout.Get(op.Timeout, p.timeouts.ExecDuration()),
 		operation:       opcommand.New(op, p.test.BasePath, p.namespacer.GetNamespace()),
-		operationReport: operationReport,
+		operationReport: newOperationReport("Command", report.OperationTypeCommand, nil),
 	}
 }
 
@@ -309,18 +373,15 @@ func (p *stepProcessor) createOperation(ctx context.Context, op v1alpha1.Create)
 		return nil, err
 	}
 	var ops []operation
-	operationReport := report.NewOperation("Create ", report.OperationTypeCreate)
-	if p.stepReport != nil {
-		p.stepReport.AddOperation(operationReport)
-	}
 	dryRun := op.DryRun != nil && *op.DryRun
 	for _, resource := range resources {
 		if err := p.prepareResource(resource); err != nil {
 			return nil, err
 		}
 		ops = append(ops, operation{
-			timeout:   timeout.Get(op.Timeout, p.timeouts.ApplyDuration()),
-			operation: opcreate.New(p.getClient(dryRun), resource, p.namespacer, p.getCleaner(ctx, dryRun), op.Expect...),
+			timeout:         timeout.Get(op.Timeout, p.timeouts.ApplyDuration()),
+			operation:       opcreate.New(p.getClient(dryRun), resource, p.namespacer, p.getCleaner(ctx, dryRun), p.getTemplate(op.Template), p.polling.Combine(op.Polling), op.Expect...),
+			operationReport: newOperationReport("Create", report.OperationTypeCreate, &resource),
 		})
 	}
 	return ops, nil
@@ -333,60 +394,177 @@ func (p *stepProcessor) deleteOperation(ctx context.Context, op v1alpha1.Delete)
 	resource.SetName(op.Name)
 	resource.SetNamespace(op.Namespace)
 	resource.SetLabels(op.Labels)
-	operationReport := report.NewOperation("Delete ", report.OperationTypeDelete)
-	if p.stepReport != nil {
-		p.stepReport.AddOperation(operationReport)
-	}
 	return &operation{
 		timeout:         timeout.Get(op.Timeout, p.timeouts.DeleteDuration()),
-		operation:       opdelete.New(p.client, resource, p.namespacer, op.Expect...),
-		operationReport: operationReport,
+		operation:       opdelete.New(p.client, resource, p.namespacer, p.getTemplate(op.Template), p.polling, op.Expect...),
+		operationReport: newOperationReport("Delete", report.OperationTypeDelete, &resource),
 	}, nil
 }
 
+func (p *stepProcessor) describeOperation(ctx context.Context, op v1alpha1.Describe) operation {
+	var resource unstructured.Unstructured
+	resource.SetAPIVersion(op.APIVersion)
+	resource.SetKind(op.Kind)
+	resource.SetName(op.Name)
+	resource.SetNamespace(op.Namespace)
+	resource.SetLabels(op.Labels)
+	return operation{
+		timeout:         timeout.Get(op.Timeout, p.timeouts.ExecDuration()),
+		operation:       opdescribe.New(p.client, resource, p.namespacer),
+		operationReport: newOperationReport("Describe", report.OperationTypeDescribe, &resource),
+	}
+}
+
 func (p *stepProcessor) errorOperation(ctx context.Context, op v1alpha1.Error) ([]operation, error) {
 	resources, err := p.fileRefOrResource(op.FileRefOrResource)
 	if err != nil {
 		return nil, err
 	}
 	var ops []operation
-	operationReport := report.NewOperation("Error ", report.OperationTypeCommand)
-	if p.stepReport != nil {
-		p.stepReport.AddOperation(operationReport)
-	}
 	for _, resource := range resources {
 		ops = append(ops, operation{
 			timeout:         timeout.Get(op.Timeout, p.timeouts.ErrorDuration()),
-			operation:       operror.New(p.client, resource, p.namespacer),
-			operationReport: operationReport,
+			operation:       operror.New(p.client, resource, p.namespacer, p.getTemplate(op.Template), p.polling.Combine(op.Polling)),
+			operationReport: newOperationReport("Error", report.OperationTypeError, &resource),
 		})
 	}
 	return ops, nil
 }
 
-func (p *stepProcessor) scriptOperation(ctx context.Context, op v1alpha1.Script) operation {
-	operationReport := report.NewOperation("Script ", report.OperationTypeScript)
-	if p.stepReport != nil {
-		p.stepReport.AddOperation(operationReport)
+func (p *stepProcessor) eventsOperation(ctx context.Context, op v1alpha1.Events) operation {
+	return operation{
+		timeout:         timeout.Get(op.Timeout, p.timeouts.ExecDuration()),
+		operation:       opevents.New(p.client, op, p.namespacer.GetNamespace()),
+		operationReport: newOperationReport("Events", report.OperationTypeEvents, nil),
+	}
+}
+
+func (p *stepProcessor) execOperation(ctx context.Context, op v1alpha1.Exec) (*operation, error) {
+	if p.cfg == nil {
+		return nil, errors.New("commands can't be executed in pods without a rest config")
+	}
+	return &operation{
+		timeout:         timeout.Get(op.Timeout, p.timeouts.ExecDuration()),
+		operation:       opexec.New(p.clientset, p.cfg, op, p.namespacer.GetNamespace()),
+		operationReport: newOperationReport("Exec", report.OperationTypeExec, nil),
+	}, nil
+}
+
+func (p *stepProcessor) getOperation(ctx context.Context, op v1alpha1.Get) operation {
+	var resource unstructured.Unstructured
+	resource.SetAPIVersion(op.APIVersion)
+	resource.SetKind(op.Kind)
+	resource.SetName(op.Name)
+	resource.SetNamespace(op.Namespace)
+	resource.SetLabels(op.Labels)
+	return operation{
+		timeout:         timeout.Get(op.Timeout, p.timeouts.ExecDuration()),
+		operation:       opget.New(p.client, resource, p.namespacer),
+		operationReport: newOperationReport("Get", report.OperationTypeGet, &resource),
+	}
+}
+
+func (p *stepProcessor) httpOperation(ctx context.Context, op v1alpha1.HTTP) (*operation, error) {
+	if op.Target != nil && p.cfg == nil {
+		return nil, errors.New("http requests can't target services or pods without a rest config")
+	}
+	return &operation{
+		timeout:         timeout.Get(op.Timeout, p.timeouts.AssertDuration()),
+		operation:       ophttp.New(p.clientset, p.cfg, op, p.test.BasePath, p.namespacer.GetNamespace(), p.polling.Combine(op.Polling)),
+		operationReport: newOperationReport("HTTP", report.OperationTypeHTTP, nil),
+	}, nil
+}
+
+func (p *stepProcessor) patchOperation(ctx context.Context, op v1alpha1.Patch) (*operation, error) {
+	var resource unstructured.Unstructured
+	resource.SetAPIVersion(op.APIVersion)
+	resource.SetKind(op.Kind)
+	resource.SetName(op.Name)
+	resource.SetNamespace(op.Namespace)
+	resource.SetLabels(op.Labels)
+	patch, err := json.Marshal(op.Patch.Value)
+	if err != nil {
+		return nil, err
+	}
+	var patchType types.PatchType
+	switch op.Type {
+	case v1alpha1.JSONPatch:
+		patchType = types.JSONPatchType
+	case v1alpha1.StrategicMergePatch:
+		patchType = types.StrategicMergePatchType
+	default:
+		patchType = types.MergePatchType
 	}
+	dryRun := op.DryRun != nil && *op.DryRun
+	return &operation{
+		timeout:         timeout.Get(op.Timeout, p.timeouts.ApplyDuration()),
+		operation:       oppatch.New(p.getClient(dryRun), resource, p.namespacer, patchType, patch, op.Expect...),
+		operationReport: newOperationReport("Patch", report.OperationTypePatch, &resource),
+	}, nil
+}
+
+func (p *stepProcessor) podLogsOperation(ctx context.Context, op v1alpha1.PodLogs) (*operation, error) {
+	if p.cfg == nil {
+		return nil, errors.New("pod logs can't be collected without a rest config")
+	}
+	return &operation{
+		timeout:         timeout.Get(op.Timeout, p.timeouts.ExecDuration()),
+		operation:       oppodlogs.New(p.clientset, op, p.namespacer.GetNamespace()),
+		operationReport: newOperationReport("Pod logs", report.OperationTypePodLogs, nil),
+	}, nil
+}
+
+func (p *stepProcessor) scriptOperation(ctx context.Context, op v1alpha1.Script) operation {
 	return operation{
 		timeout:         timeout.Get(op.Timeout, p.timeouts.ExecDuration()),
 		operation:       opscript.New(op, p.test.BasePath, p.namespacer.GetNamespace()),
-		operationReport: operationReport,
+		operationReport: newOperationReport("Script", report.OperationTypeScript, nil),
 	}
 }
 
 func (p *stepProcessor) sleepOperation(ctx context.Context, sleep v1alpha1.Sleep) operation {
-	operationReport := report.NewOperation("Sleep ", report.OperationTypeSleep)
-	if p.stepReport != nil {
-		p.stepReport.AddOperation(operationReport)
+	var template bool
+	polling := p.polling
+	if sleep.Until != nil {
+		template = p.getTemplate(sleep.Until.Template)
+		polling = p.polling.Combine(sleep.Until.Polling)
+	}
+	return operation{
+		// the sleep stops by itself once its duration elapsed, the exec timeout bounds the last until evaluation
+		timeout:         timeout.Get(nil, sleep.Duration.Duration+p.timeouts.ExecDuration()),
+		operation:       opsleep.New(p.client, p.namespacer, template, polling, sleep),
+		operationReport: newOperationReport("Sleep", report.OperationTypeSleep, nil),
 	}
+}
+
+func (p *stepProcessor) waitOperation(ctx context.Context, op v1alpha1.Wait) operation {
+	var resource unstructured.Unstructured
+	resource.SetAPIVersion(op.APIVersion)
+	resource.SetKind(op.Kind)
+	resource.SetName(op.Name)
+	resource.SetNamespace(op.Namespace)
+	resource.SetLabels(op.Labels)
 	return operation{
-		operation:       opsleep.New(sleep),
-		operationReport: operationReport,
+		timeout:         timeout.Get(op.Timeout, p.timeouts.WaitDuration()),
+		operation:       opwait.New(p.client, resource, p.namespacer, p.getTemplate(op.Template), p.polling.Combine(op.Polling), op.For),
+		operationReport: newOperationReport("Wait", report.OperationTypeWait, &resource),
 	}
 }
 
+func newOperationReport(name string, operationType report.OperationType, resource *unstructured.Unstructured) *report.OperationReport {
+	if resource == nil {
+		return report.NewOperation(name, operationType)
+	}
+	if kind, resourceName := resource.GetKind(), resource.GetName(); resourceName != "" {
+		name = fmt.Sprintf("%s %s/%s", name, kind, resourceName)
+	} else if kind != "" {
+		name = fmt.Sprintf("%s %s", name, kind)
+	}
+	operationReport := report.NewOperation(name, operationType)
+	operationReport.SetResource(*resource)
+	return operationReport
+}
+
 func (p *stepProcessor) fileRefOrResource(ref v1alpha1.FileRefOrResource) ([]unstructured.Unstructured, error) {
 	if ref.Resource != nil {
 		return []unstructured.Unstructured{*ref.Resource}, nil
@@ -436,6 +614,16 @@ func (p *stepProcessor) getClient(dryRun bool) client.Client {
 	return client.DryRun(p.client)
 }
 
+func (p *stepProcessor) getTemplate(template *bool) bool {
+	if template != nil {
+		return *template
+	}
+	if p.test.Spec.Template != nil {
+		return *p.test.Spec.Template
+	}
+	return p.config.Template
+}
+
 func (p *stepProcessor) getCleaner(ctx context.Context, dryRun bool) cleanup.Cleaner {
 	if dryRun {
 		return nil
@@ -444,6 +632,6 @@ func (p *stepProcessor) getCleaner(ctx context.Context, dryRun bool) cleanup.Cle
 		return nil
 	}
 	return func(obj unstructured.Unstructured, c client.Client) {
-		p.cleaner.register(obj, c, timeout.Get(nil, p.timeouts.CleanupDuration()))
+		p.cleaner.register(obj, c, timeout.Get(nil, p.timeouts.CleanupDuration()), p.polling)
 	}
 }
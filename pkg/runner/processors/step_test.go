@@ -0,0 +1,106 @@
+package processors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/kyverno/chainsaw/pkg/discovery"
+	"github.com/kyverno/chainsaw/pkg/report"
+	"github.com/kyverno/chainsaw/pkg/runner/logging"
+	tlogging "github.com/kyverno/chainsaw/pkg/runner/logging/testing"
+	ttesting "github.com/kyverno/chainsaw/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	tclock "k8s.io/utils/clock/testing"
+)
+
+func Test_newOperationReport(t *testing.T) {
+	resource := func(kind, namespace, name string) *unstructured.Unstructured {
+		var resource unstructured.Unstructured
+		resource.SetAPIVersion("v1")
+		resource.SetKind(kind)
+		resource.SetNamespace(namespace)
+		resource.SetName(name)
+		return &resource
+	}
+	tests := []struct {
+		name          string
+		operationType report.OperationType
+		resource      *unstructured.Unstructured
+		wantName      string
+		wantResource  *report.ResourceReference
+	}{{
+		name:          "Command",
+		operationType: report.OperationTypeCommand,
+		wantName:      "Command",
+	}, {
+		name:          "Apply",
+		operationType: report.OperationTypeApply,
+		resource:      resource("ConfigMap", "default", "quick-start"),
+		wantName:      "Apply ConfigMap/quick-start",
+		wantResource:  &report.ResourceReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "quick-start"},
+	}, {
+		name:          "Error",
+		operationType: report.OperationTypeError,
+		resource:      resource("Pod", "", ""),
+		wantName:      "Error Pod",
+		wantResource:  &report.ResourceReference{APIVersion: "v1", Kind: "Pod"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newOperationReport(tt.name, tt.operationType, tt.resource)
+			assert.Equal(t, tt.wantName, got.Name)
+			assert.Equal(t, tt.operationType, got.OperationType)
+			assert.Equal(t, tt.wantResource, got.Resource)
+		})
+	}
+}
+
+func Test_stepProcessor_sleepOperation(t *testing.T) {
+	tests := []struct {
+		name        string
+		timeouts    v1alpha1.Timeouts
+		sleep       v1alpha1.Sleep
+		wantTimeout time.Duration
+	}{{
+		name: "default",
+		sleep: v1alpha1.Sleep{
+			Duration: metav1.Duration{Duration: 50 * time.Millisecond},
+		},
+		wantTimeout: 50*time.Millisecond + v1alpha1.DefaultExecTimeout,
+	}, {
+		name: "exec timeout",
+		timeouts: v1alpha1.Timeouts{
+			Exec: &metav1.Duration{Duration: time.Second},
+		},
+		sleep: v1alpha1.Sleep{
+			Duration: metav1.Duration{Duration: 50 * time.Millisecond},
+		},
+		wantTimeout: 50*time.Millisecond + time.Second,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := v1alpha1.ConfigurationSpec{
+				Timeouts: tt.timeouts,
+			}
+			test := discovery.Test{
+				Test: &v1alpha1.Test{},
+			}
+			p := NewStepProcessor(config, nil, nil, nil, nil, tclock.NewFakePassiveClock(time.Now()), test, v1alpha1.TestSpecStep{}, nil, nil)
+			op := p.(*stepProcessor).sleepOperation(context.TODO(), tt.sleep)
+			assert.NotNil(t, op.timeout)
+			assert.Equal(t, tt.wantTimeout, *op.timeout)
+			// reaching the sleep duration within the operation timeout is not a failure
+			nt := ttesting.MockT{}
+			ctx := ttesting.IntoContext(logging.IntoContext(context.Background(), &tlogging.FakeLogger{}), &nt)
+			start := time.Now()
+			op.execute(ctx, nil)
+			assert.GreaterOrEqual(t, time.Since(start), tt.sleep.Duration.Duration)
+			assert.False(t, nt.FailedVar)
+			assert.Equal(t, "Operation completed successfully", op.operationReport.Message)
+		})
+	}
+}
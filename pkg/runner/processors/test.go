@@ -3,12 +3,17 @@ package processors
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync/atomic"
 
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/client"
 	"github.com/kyverno/chainsaw/pkg/discovery"
 	"github.com/kyverno/chainsaw/pkg/report"
+	"github.com/kyverno/chainsaw/pkg/runner/artifacts"
+	runnerbindings "github.com/kyverno/chainsaw/pkg/runner/bindings"
 	"github.com/kyverno/chainsaw/pkg/runner/cleanup"
 	"github.com/kyverno/chainsaw/pkg/runner/logging"
 	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
@@ -19,6 +24,8 @@ import (
 	"github.com/kyverno/kyverno/ext/output/color"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/utils/clock"
 )
 
@@ -30,6 +37,8 @@ type TestProcessor interface {
 func NewTestProcessor(
 	config v1alpha1.ConfigurationSpec,
 	client client.Client,
+	cfg *rest.Config,
+	clientset kubernetes.Interface,
 	clock clock.PassiveClock,
 	summary *summary.Summary,
 	testReport *report.TestReport,
@@ -39,33 +48,41 @@ func NewTestProcessor(
 	return &testProcessor{
 		config:         config,
 		client:         client,
+		cfg:            cfg,
+		clientset:      clientset,
 		clock:          clock,
 		summary:        summary,
 		testReport:     testReport,
 		test:           test,
 		shouldFailFast: shouldFailFast,
 		timeouts:       config.Timeouts.Combine(test.Spec.Timeouts),
+		polling:        v1alpha1.Polling{}.Combine(config.Polling).Combine(test.Spec.Polling),
 	}
 }
 
 type testProcessor struct {
 	config         v1alpha1.ConfigurationSpec
 	client         client.Client
+	cfg            *rest.Config
+	clientset      kubernetes.Interface
 	clock          clock.PassiveClock
 	summary        *summary.Summary
 	testReport     *report.TestReport
 	test           discovery.Test
 	shouldFailFast *atomic.Bool
 	timeouts       v1alpha1.Timeouts
+	polling        v1alpha1.Polling
 }
 
 func (p *testProcessor) Run(ctx context.Context, nspacer namespacer.Namespacer) {
 	t := testing.FromContext(ctx)
+	// a flaky test passed after being retried, the failures of previous attempts don't count
+	var flaky bool
 	t.Cleanup(func() {
-		if t.Failed() {
-			p.testReport.NewFailure("test failed")
-		}
 		if p.testReport != nil {
+			if t.Failed() && !flaky {
+				p.testReport.NewFailure("test failed")
+			}
 			p.testReport.MarkTestEnd()
 		}
 	})
@@ -84,7 +101,9 @@ func (p *testProcessor) Run(ctx context.Context, nspacer namespacer.Namespacer)
 			if t.Skipped() {
 				p.summary.IncSkipped()
 			} else {
-				if t.Failed() {
+				if flaky {
+					p.summary.IncFlaky()
+				} else if t.Failed() {
 					p.summary.IncFailed()
 				} else {
 					p.summary.IncPassed()
@@ -92,19 +111,87 @@ func (p *testProcessor) Run(ctx context.Context, nspacer namespacer.Namespacer)
 			}
 		})
 	}
+	if p.shouldFailFast != nil {
+		t.Cleanup(func() {
+			if t.Failed() && !flaky {
+				p.shouldFailFast.Store(true)
+			}
+		})
+	}
 	if p.test.Spec.Concurrent == nil || *p.test.Spec.Concurrent {
 		t.Parallel()
 	}
-	if p.test.Spec.Skip != nil && *p.test.Spec.Skip {
+	skip := func(reason string) {
+		if p.testReport != nil {
+			p.testReport.MarkTestSkipped(reason)
+		}
 		t.SkipNow()
 	}
+	if p.test.Spec.Skip != nil && *p.test.Spec.Skip {
+		skip("test is marked as skipped")
+	}
 	if p.config.FailFast {
 		if p.shouldFailFast.Load() {
-			t.SkipNow()
+			skip("a previous test failed and fail fast is enabled")
 		}
 	}
+	// the run was interrupted before the test started
+	if ctx.Err() != nil {
+		skip("the run was interrupted")
+	}
+	retries := p.retries()
+	if retries == 0 {
+		p.run(ctx, nspacer, size, 0)
+		return
+	}
+	retryLogger := logging.NewLogger(t, p.clock, p.test.Name, fmt.Sprintf("%-*s", size, "@retry"))
+	// every attempt runs in its own subtest, its resources are cleaned up when the subtest ends
+	for attempt := 1; ; attempt++ {
+		if p.testReport != nil {
+			p.testReport.NewAttempt()
+		}
+		passed := t.Run(fmt.Sprintf("attempt-%d", attempt), func(t *testing.T) {
+			t.Helper()
+			p.run(testing.IntoContext(ctx, t), nspacer, size, attempt)
+		})
+		if passed {
+			if attempt > 1 {
+				flaky = true
+				if p.testReport != nil {
+					p.testReport.MarkTestFlaky()
+				}
+			}
+			return
+		}
+		// don't retry if the run was interrupted
+		if attempt > retries || ctx.Err() != nil {
+			return
+		}
+		retryLogger.Log(logging.Retry, logging.RunStatus, color.BoldYellow, logging.Section("ATTEMPT", fmt.Sprintf("%d/%d", attempt+1, retries+1)))
+	}
+}
+
+// artifactsFolder returns the name of the test artifacts folder.
+// Test names are not unique across folders, the name is prefixed with the test path relative to the working directory.
+func artifactsFolder(test discovery.Test) string {
+	path := test.BasePath
+	if cwd, err := os.Getwd(); err == nil {
+		if abs, err := filepath.Abs(path); err == nil {
+			if rel, err := filepath.Rel(cwd, abs); err == nil {
+				path = rel
+			}
+		}
+	}
+	return artifacts.Sanitize(filepath.Join(path, test.Name))
+}
+
+// run executes an attempt of the test, attempt is zero when retries are disabled.
+func (p *testProcessor) run(ctx context.Context, nspacer namespacer.Namespacer, size int, attempt int) {
+	t := testing.FromContext(ctx)
 	setupLogger := logging.NewLogger(t, p.clock, p.test.Name, fmt.Sprintf("%-*s", size, "@setup"))
 	cleanupLogger := logging.NewLogger(t, p.clock, p.test.Name, fmt.Sprintf("%-*s", size, "@cleanup"))
+	// cleanup must happen even if the run was interrupted
+	cleanupCtx := logging.IntoContext(context.WithoutCancel(ctx), cleanupLogger)
 	var namespace *corev1.Namespace
 	if nspacer == nil || p.test.Spec.Namespace != "" {
 		var ns corev1.Namespace
@@ -118,7 +205,6 @@ func (p *testProcessor) Run(ctx context.Context, nspacer namespacer.Namespacer)
 	if namespace != nil {
 		nspacer = namespacer.New(p.client, namespace.Name)
 		setupCtx := logging.IntoContext(ctx, setupLogger)
-		cleanupCtx := logging.IntoContext(ctx, cleanupLogger)
 		if err := p.client.Get(setupCtx, client.ObjectKey(namespace), namespace.DeepCopy()); err != nil {
 			if !errors.IsNotFound(err) {
 				// Get doesn't log
@@ -130,9 +216,9 @@ func (p *testProcessor) Run(ctx context.Context, nspacer namespacer.Namespacer)
 					operation := operation{
 						continueOnError: false,
 						timeout:         timeout.Get(nil, p.timeouts.CleanupDuration()),
-						operation:       opdelete.New(p.client, client.ToUnstructured(namespace), nspacer),
+						operation:       opdelete.New(p.client, client.ToUnstructured(namespace), nspacer, false, p.polling),
 					}
-					operation.execute(cleanupCtx)
+					operation.execute(cleanupCtx, nil)
 				})
 			}
 			if err := p.client.Create(logging.IntoContext(setupCtx, setupLogger), namespace.DeepCopy()); err != nil {
@@ -146,16 +232,85 @@ func (p *testProcessor) Run(ctx context.Context, nspacer namespacer.Namespacer)
 	}
 	cleaner := newCleaner(nspacer, delay)
 	t.Cleanup(func() {
-		cleaner.run(logging.IntoContext(ctx, cleanupLogger))
+		cleaner.run(cleanupCtx)
 	})
-	for i, step := range p.test.Spec.Steps {
+	var artifactsDir string
+	if p.config.ArtifactsDir != "" {
+		artifactsDir = filepath.Join(p.config.ArtifactsDir, artifactsFolder(p.test))
+		if p.testReport != nil {
+			p.testReport.Artifacts = artifactsDir
+		}
+		if attempt != 0 {
+			artifactsDir = filepath.Join(artifactsDir, fmt.Sprintf("attempt-%d", attempt))
+		}
+	}
+	if p.config.OnFailure != nil && p.config.OnFailure.Dump != nil {
+		dumpLogger := logging.NewLogger(t, p.clock, p.test.Name, fmt.Sprintf("%-*s", size, "@dump"))
+		t.Cleanup(func() {
+			// don't dump the cluster state if the run was interrupted
+			if t.Failed() && ctx.Err() == nil {
+				dumpCtx := logging.IntoContext(ctx, dumpLogger)
+				if artifactsDir != "" {
+					dumpCtx = artifacts.IntoContext(dumpCtx, artifacts.New(filepath.Join(artifactsDir, "dump")))
+				}
+				operations, err := p.dumpOperations(nspacer, cleaner, *p.config.OnFailure.Dump)
+				if err != nil {
+					dumpLogger.Log(logging.Dump, logging.ErrorStatus, color.BoldRed, logging.ErrSection(err))
+					return
+				}
+				for _, operation := range operations {
+					operation.execute(dumpCtx, nil)
+				}
+			}
+		})
+	}
+	bindings := binding.NewBindings()
+	if nspacer != nil {
+		bindings = runnerbindings.RegisterNamedBinding(bindings, "namespace", nspacer.GetNamespace())
+	}
+	bindings = runnerbindings.RegisterNamedBinding(bindings, "test", map[string]any{
+		"name": p.test.Name,
+	})
+	bindings, err := runnerbindings.RegisterBindings(ctx, bindings, nil, p.test.Spec.Bindings...)
+	if err != nil {
+		setupLogger.Log(logging.Bindings, logging.ErrorStatus, color.BoldRed, logging.ErrSection(err))
+		t.FailNow()
+	}
+	for _, i := range p.stepsOrder() {
+		step := p.test.Spec.Steps[i]
 		processor := p.CreateStepProcessor(nspacer, cleaner, step)
 		name := step.Name
 		if name == "" {
 			name = fmt.Sprintf("step-%d", i+1)
 		}
-		processor.Run(logging.IntoContext(ctx, logging.NewLogger(t, p.clock, p.test.Name, fmt.Sprintf("%-*s", size, name))))
+		stepCtx := logging.IntoContext(ctx, logging.NewLogger(t, p.clock, p.test.Name, fmt.Sprintf("%-*s", size, name)))
+		if artifactsDir != "" {
+			stepCtx = artifacts.IntoContext(stepCtx, artifacts.New(filepath.Join(artifactsDir, fmt.Sprintf("%02d-%s", i+1, artifacts.Sanitize(name)))))
+		}
+		bindings = processor.Run(stepCtx, bindings)
+	}
+}
+
+// stepsOrder returns the indices of the steps in the order they run, steps are shuffled only if the test allows it.
+func (p *testProcessor) stepsOrder() []int {
+	if seed, ok := shuffleSeed(p.config.Shuffle); ok && p.test.Spec.ShuffleSteps {
+		return shuffledIndices(len(p.test.Spec.Steps), stepsSeed(seed, p.test.Name))
+	}
+	order := make([]int, len(p.test.Spec.Steps))
+	for i := range order {
+		order[i] = i
+	}
+	return order
+}
+
+func (p *testProcessor) retries() int {
+	if p.test.Spec.Retries != nil {
+		return *p.test.Spec.Retries
+	}
+	if p.config.Retries != nil {
+		return *p.config.Retries
 	}
+	return 0
 }
 
 func (p *testProcessor) CreateStepProcessor(nspacer namespacer.Namespacer, cleaner *cleaner, step v1alpha1.TestSpecStep) StepProcessor {
@@ -163,5 +318,5 @@ func (p *testProcessor) CreateStepProcessor(nspacer namespacer.Namespacer, clean
 	if p.testReport != nil {
 		p.testReport.AddTestStep(stepReport)
 	}
-	return NewStepProcessor(p.config, p.client, nspacer, p.clock, p.test, step, stepReport, cleaner)
+	return NewStepProcessor(p.config, p.client, p.cfg, p.clientset, nspacer, p.clock, p.test, step, stepReport, cleaner)
 }
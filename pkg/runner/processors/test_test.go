@@ -0,0 +1,231 @@
+package processors
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	gotesting "testing"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	fake "github.com/kyverno/chainsaw/pkg/client/testing"
+	"github.com/kyverno/chainsaw/pkg/discovery"
+	"github.com/kyverno/chainsaw/pkg/report"
+	"github.com/kyverno/chainsaw/pkg/runner/namespacer"
+	"github.com/kyverno/chainsaw/pkg/runner/summary"
+	"github.com/kyverno/chainsaw/pkg/testing"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	tclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+)
+
+func TestTestProcessor_Retries(t *testing.T) {
+	tests := []struct {
+		name           string
+		configRetries  *int
+		testRetries    *int
+		failures       int
+		wantPassed     int32
+		wantFailed     int32
+		wantFlaky      int32
+		wantAttempts   int
+		wantFailure    bool
+		wantFailFast   bool
+		wantExecutions int
+	}{{
+		name:           "no retries",
+		failures:       0,
+		wantPassed:     1,
+		wantExecutions: 1,
+	}, {
+		name:           "no retries with failure",
+		failures:       1,
+		wantFailed:     1,
+		wantFailure:    true,
+		wantFailFast:   true,
+		wantExecutions: 1,
+	}, {
+		name:           "passes first attempt",
+		configRetries:  ptr.To(2),
+		failures:       0,
+		wantPassed:     1,
+		wantAttempts:   1,
+		wantExecutions: 1,
+	}, {
+		name:           "flaky",
+		configRetries:  ptr.To(2),
+		failures:       1,
+		wantFlaky:      1,
+		wantAttempts:   2,
+		wantExecutions: 2,
+	}, {
+		name:           "test overrides configuration",
+		configRetries:  ptr.To(2),
+		testRetries:    ptr.To(0),
+		failures:       1,
+		wantFailed:     1,
+		wantFailure:    true,
+		wantFailFast:   true,
+		wantExecutions: 1,
+	}, {
+		name:           "fails all attempts",
+		testRetries:    ptr.To(2),
+		failures:       5,
+		wantFailed:     1,
+		wantAttempts:   3,
+		wantFailure:    true,
+		wantFailFast:   true,
+		wantExecutions: 3,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the script fails the first configured number of executions
+			counter := filepath.Join(t.TempDir(), "counter")
+			script := fmt.Sprintf(`echo x >> %[1]s; test "$(wc -l < %[1]s)" -gt %[2]d`, counter, tt.failures)
+			test := discovery.Test{
+				BasePath: t.TempDir(),
+				Test: &v1alpha1.Test{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test",
+					},
+					Spec: v1alpha1.TestSpec{
+						Concurrent: ptr.To(false),
+						Retries:    tt.testRetries,
+						Steps: []v1alpha1.TestSpecStep{{
+							TestStepSpec: v1alpha1.TestStepSpec{
+								Try: []v1alpha1.Operation{{
+									Script: &v1alpha1.Script{
+										Content: script,
+									},
+								}},
+							},
+						}},
+					},
+				},
+			}
+			config := v1alpha1.ConfigurationSpec{
+				Retries: tt.configRetries,
+			}
+			client := &fake.FakeClient{}
+			var testsSummary *summary.Summary
+			var shouldFailFast *atomic.Bool
+			var testReport *report.TestReport
+			// run the test with the testing framework, failures must not fail this test
+			// the framework runs it as many times as the count flag says, every run starts from a clean state
+			gotesting.RunTests(func(string, string) (bool, error) { return true, nil }, []gotesting.InternalTest{{
+				Name: "test",
+				F: func(t *gotesting.T) {
+					_ = os.Remove(counter)
+					testsSummary = &summary.Summary{}
+					shouldFailFast = &atomic.Bool{}
+					testReport = report.NewTest("test")
+					processor := NewTestProcessor(config, client, nil, nil, tclock.NewFakePassiveClock(metav1.Now().Time), testsSummary, testReport, test, shouldFailFast)
+					processor.Run(testing.IntoContext(context.Background(), t), namespacer.New(client, "default"))
+				},
+			}})
+			assert.Equal(t, tt.wantPassed, testsSummary.Passed())
+			assert.Equal(t, tt.wantFailed, testsSummary.Failed())
+			assert.Equal(t, tt.wantFlaky, testsSummary.Flaky())
+			assert.Equal(t, tt.wantAttempts, testReport.Attempts)
+			assert.Equal(t, tt.wantFlaky == 1, testReport.Flaky)
+			assert.Equal(t, tt.wantFailure, testReport.Failure != nil)
+			assert.Equal(t, tt.wantFailFast, shouldFailFast.Load())
+			assert.Len(t, testReport.Steps, 1)
+			executions, err := os.ReadFile(counter)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantExecutions, strings.Count(string(executions), "x"))
+		})
+	}
+}
+
+func Test_artifactsFolder(t *testing.T) {
+	test := func(basePath string) discovery.Test {
+		return discovery.Test{
+			BasePath: basePath,
+			Test: &v1alpha1.Test{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-test",
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name string
+		test discovery.Test
+		want string
+	}{{
+		name: "working directory",
+		test: test("."),
+		want: "my-test",
+	}, {
+		name: "folder",
+		test: test("e2e/foo"),
+		want: "e2e-foo-my-test",
+	}, {
+		name: "other folder with same test name",
+		test: test("e2e/bar"),
+		want: "e2e-bar-my-test",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, artifactsFolder(tt.test))
+		})
+	}
+}
+
+func TestTestProcessor_Artifacts(t *testing.T) {
+	// two tests with the same name in different folders
+	var tests []discovery.Test
+	for i := 0; i < 2; i++ {
+		tests = append(tests, discovery.Test{
+			BasePath: t.TempDir(),
+			Test: &v1alpha1.Test{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: v1alpha1.TestSpec{
+					Concurrent: ptr.To(false),
+					Steps: []v1alpha1.TestSpecStep{{
+						TestStepSpec: v1alpha1.TestStepSpec{
+							Try: []v1alpha1.Operation{{
+								Script: &v1alpha1.Script{
+									Content: "echo hello",
+								},
+							}},
+						},
+					}},
+				},
+			},
+		})
+	}
+	config := v1alpha1.ConfigurationSpec{
+		ArtifactsDir: t.TempDir(),
+	}
+	client := &fake.FakeClient{}
+	var testReports []*report.TestReport
+	gotesting.RunTests(func(string, string) (bool, error) { return true, nil }, []gotesting.InternalTest{{
+		Name: "test",
+		F: func(t *gotesting.T) {
+			testReports = nil
+			for _, test := range tests {
+				var summary summary.Summary
+				var shouldFailFast atomic.Bool
+				testReport := report.NewTest("test")
+				testReports = append(testReports, testReport)
+				processor := NewTestProcessor(config, client, nil, nil, tclock.NewFakePassiveClock(metav1.Now().Time), &summary, testReport, test, &shouldFailFast)
+				processor.Run(testing.IntoContext(context.Background(), t), namespacer.New(client, "default"))
+			}
+		},
+	}})
+	assert.Len(t, testReports, 2)
+	assert.NotEqual(t, testReports[0].Artifacts, testReports[1].Artifacts)
+	for _, testReport := range testReports {
+		assert.Equal(t, config.ArtifactsDir, filepath.Dir(testReport.Artifacts))
+		files, err := filepath.Glob(filepath.Join(testReport.Artifacts, "*", "*"))
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+	}
+}
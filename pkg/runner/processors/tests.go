@@ -2,6 +2,7 @@ package processors
 
 import (
 	"context"
+	"strconv"
 	"sync/atomic"
 
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
@@ -18,6 +19,9 @@ import (
 	"github.com/kyverno/chainsaw/pkg/testing"
 	"github.com/kyverno/kyverno/ext/output/color"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/utils/clock"
 )
 
@@ -29,6 +33,8 @@ type TestsProcessor interface {
 func NewTestsProcessor(
 	config v1alpha1.ConfigurationSpec,
 	client client.Client,
+	cfg *rest.Config,
+	clientset kubernetes.Interface,
 	clock clock.PassiveClock,
 	summary *summary.Summary,
 	testsReport *report.TestsReport,
@@ -37,20 +43,26 @@ func NewTestsProcessor(
 	return &testsProcessor{
 		config:      config,
 		client:      client,
+		cfg:         cfg,
+		clientset:   clientset,
 		clock:       clock,
 		summary:     summary,
 		testsReport: testsReport,
 		tests:       tests,
+		runID:       rand.String(8),
 	}
 }
 
 type testsProcessor struct {
 	config         v1alpha1.ConfigurationSpec
 	client         client.Client
+	cfg            *rest.Config
+	clientset      kubernetes.Interface
 	clock          clock.PassiveClock
 	summary        *summary.Summary
 	testsReport    *report.TestsReport
 	tests          []discovery.Test
+	runID          string
 	shouldFailFast atomic.Bool
 }
 
@@ -76,17 +88,26 @@ func (p *testsProcessor) Run(ctx context.Context) {
 					operation := operation{
 						continueOnError: false,
 						timeout:         timeout.Get(nil, p.config.Timeouts.CleanupDuration()),
-						operation:       opdelete.New(p.client, client.ToUnstructured(namespace.DeepCopy()), nspacer),
+						operation:       opdelete.New(p.client, client.ToUnstructured(namespace.DeepCopy()), nspacer, false, v1alpha1.Polling{}.Combine(p.config.Polling)),
 					}
-					operation.execute(ctx)
+					// cleanup must happen even if the run was interrupted
+					operation.execute(context.WithoutCancel(ctx), nil)
 				})
 			}
-			if err := p.client.Create(ctx, namespace.DeepCopy()); err != nil {
+			// the configured namespace is shared by all tests, don't label it with a test name
+			if err := client.WithLabels(p.client, p.labels()).Create(ctx, namespace.DeepCopy()); err != nil {
 				t.FailNow()
 			}
 		}
 	}
-	for _, test := range p.tests {
+	tests := p.tests
+	if seed, ok := shuffleSeed(p.config.Shuffle); ok {
+		tests = make([]discovery.Test, 0, len(p.tests))
+		for _, i := range shuffledIndices(len(p.tests), seed) {
+			tests = append(tests, p.tests[i])
+		}
+	}
+	for _, test := range tests {
 		name, err := names.Test(p.config, test)
 		if err != nil {
 			logging.Log(ctx, logging.Internal, logging.ErrorStatus, color.BoldRed, logging.ErrSection(err))
@@ -94,11 +115,6 @@ func (p *testsProcessor) Run(ctx context.Context) {
 		}
 		t.Run(name, func(t *testing.T) {
 			t.Helper()
-			t.Cleanup(func() {
-				if t.Failed() {
-					p.shouldFailFast.Store(true)
-				}
-			})
 			processor := p.CreateTestProcessor(test)
 			processor.Run(testing.IntoContext(ctx, t), nspacer)
 		})
@@ -110,5 +126,18 @@ func (p *testsProcessor) CreateTestProcessor(test discovery.Test) TestProcessor
 	if p.testsReport != nil {
 		p.testsReport.AddTest(testReport)
 	}
-	return NewTestProcessor(p.config, p.client, p.clock, p.summary, testReport, test, &p.shouldFailFast)
+	labels := p.labels()
+	labels[client.TestLabel] = client.LabelValue(test.Name)
+	return NewTestProcessor(p.config, client.WithLabels(p.client, labels), p.cfg, p.clientset, p.clock, p.summary, testReport, test, &p.shouldFailFast)
+}
+
+func (p *testsProcessor) labels() map[string]string {
+	labels := map[string]string{}
+	if p.runID != "" {
+		labels[client.RunIDLabel] = p.runID
+	}
+	if p.clock != nil {
+		labels[client.CreatedAtLabel] = strconv.FormatInt(p.clock.Now().Unix(), 10)
+	}
+	return labels
 }
@@ -157,6 +157,8 @@ func TestTestsProcessor_Run(t *testing.T) {
 			processor := NewTestsProcessor(
 				tc.config,
 				tc.client,
+				nil,
+				nil,
 				tc.clock,
 				tc.summary,
 				tc.testsReport,
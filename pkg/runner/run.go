@@ -2,7 +2,11 @@ package runner
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/client"
@@ -14,26 +18,48 @@ import (
 	"github.com/kyverno/chainsaw/pkg/runner/processors"
 	"github.com/kyverno/chainsaw/pkg/runner/summary"
 	"github.com/kyverno/chainsaw/pkg/testing"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/utils/clock"
 )
 
+// InterruptedExitCode is the exit code used when tests are interrupted by a signal.
+const InterruptedExitCode = 130
+
+// ErrInterrupted is returned when tests are interrupted by a signal.
+var ErrInterrupted = errors.New("tests interrupted")
+
 type mainstart interface {
 	Run() int
 }
 
+// Run runs the tests, an interrupt or termination signal cancels the running operations,
+// cleanup still happens before returning ErrInterrupted.
 func Run(cfg *rest.Config, clock clock.PassiveClock, config v1alpha1.ConfigurationSpec, tests ...discovery.Test) (*summary.Summary, error) {
-	return run(cfg, clock, config, nil, tests...)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		// restore default signals behavior, a second signal will kill the process
+		stop()
+	}()
+	return run(ctx, cfg, clock, config, nil, tests...)
 }
 
-func run(cfg *rest.Config, clock clock.PassiveClock, config v1alpha1.ConfigurationSpec, m mainstart, tests ...discovery.Test) (*summary.Summary, error) {
+func run(ctx context.Context, cfg *rest.Config, clock clock.PassiveClock, config v1alpha1.ConfigurationSpec, m mainstart, tests ...discovery.Test) (*summary.Summary, error) {
 	var summary summary.Summary
 	var testsReport *report.TestsReport
-	if config.ReportFormat != "" {
+	if config.ReportFormat != "" || config.GitHubStepSummary {
 		testsReport = report.NewTests(config.ReportName)
+		testsReport.RerunOf = config.RerunFailed
 	}
 
 	if len(tests) == 0 {
+		// an empty selection (empty shard, rerun without failed tests) still produces a report
+		if testsReport != nil {
+			testsReport.Close()
+			return &summary, saveReport(config, testsReport)
+		}
 		return &summary, nil
 	}
 	if err := internal.SetupFlags(config); err != nil {
@@ -44,13 +70,17 @@ func run(cfg *rest.Config, clock clock.PassiveClock, config v1alpha1.Configurati
 		return nil, err
 	}
 	client = runnerclient.New(client)
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
 	internalTests := []testing.InternalTest{{
 		Name: "chainsaw",
 		F: func(t *testing.T) {
 			t.Helper()
 			t.Parallel()
-			processor := processors.NewTestsProcessor(config, client, clock, &summary, testsReport, tests...)
-			ctx := testing.IntoContext(context.Background(), t)
+			processor := processors.NewTestsProcessor(config, client, cfg, clientset, clock, &summary, testsReport, tests...)
+			ctx := testing.IntoContext(ctx, t)
 			ctx = logging.IntoContext(ctx, logging.NewLogger(t, clock, t.Name(), "@main"))
 			processor.Run(ctx)
 		},
@@ -68,10 +98,27 @@ func run(cfg *rest.Config, clock clock.PassiveClock, config v1alpha1.Configurati
 	if code := m.Run(); code > 1 {
 		return &summary, fmt.Errorf("testing framework exited with non zero code %d", code)
 	}
-	if testsReport != nil && config.ReportFormat != "" {
-		if err := testsReport.SaveReportBasedOnType(config.ReportFormat, config.ReportName); err != nil {
-			return &summary, fmt.Errorf("failed to save test report: %v", err)
+	if testsReport != nil {
+		if err := saveReport(config, testsReport); err != nil {
+			return &summary, err
 		}
 	}
+	if ctx.Err() != nil {
+		return &summary, ErrInterrupted
+	}
 	return &summary, nil
 }
+
+func saveReport(config v1alpha1.ConfigurationSpec, testsReport *report.TestsReport) error {
+	if config.ReportFormat != "" {
+		if err := testsReport.SaveReportBasedOnType(config.ReportFormat, config.ReportName); err != nil {
+			return fmt.Errorf("failed to save test report: %v", err)
+		}
+	}
+	if config.GitHubStepSummary {
+		if err := report.AppendStepSummary(testsReport); err != nil {
+			return fmt.Errorf("failed to write step summary: %v", err)
+		}
+	}
+	return nil
+}
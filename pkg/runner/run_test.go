@@ -1,11 +1,15 @@
 package runner
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/discovery"
+	"github.com/kyverno/chainsaw/pkg/report"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
@@ -143,7 +147,12 @@ func TestRun(t *testing.T) {
 			mockMainStart := &MockMainStart{
 				code: tt.mockReturn,
 			}
-			_, err := run(tt.restConfig, fakeClock, tt.config, mockMainStart, tt.tests...)
+			config := tt.config
+			if config.ReportFormat != "" {
+				// reports are written in a temporary folder
+				config.ReportName = filepath.Join(t.TempDir(), "chainsaw")
+			}
+			_, err := run(context.Background(), tt.restConfig, fakeClock, config, mockMainStart, tt.tests...)
 
 			if tt.wantErr {
 				assert.Error(t, err, "Run() should return an error")
@@ -153,3 +162,107 @@ func TestRun(t *testing.T) {
 		})
 	}
 }
+
+func TestRun_Interrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []discovery.Test{{
+		Test: &v1alpha1.Test{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test1",
+			},
+		},
+	}}
+	summary, err := run(ctx, &rest.Config{}, tclock.NewFakePassiveClock(time.Now()), v1alpha1.ConfigurationSpec{}, &MockMainStart{code: 1}, tests...)
+	assert.ErrorIs(t, err, ErrInterrupted)
+	assert.NotNil(t, summary)
+}
+
+func TestRun_GitHubStepSummary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.md")
+	t.Setenv("GITHUB_STEP_SUMMARY", path)
+	tests := []discovery.Test{{
+		Test: &v1alpha1.Test{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test1",
+			},
+		},
+	}}
+	config := v1alpha1.ConfigurationSpec{
+		ReportName:        "chainsaw",
+		GitHubStepSummary: true,
+	}
+	_, err := run(context.Background(), &rest.Config{}, tclock.NewFakePassiveClock(time.Now()), config, &MockMainStart{code: 0}, tests...)
+	assert.NoError(t, err)
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "## chainsaw\n")
+}
+
+func TestRun_RerunFailed(t *testing.T) {
+	reportName := filepath.Join(t.TempDir(), "chainsaw")
+	tests := []discovery.Test{{
+		Test: &v1alpha1.Test{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test1",
+			},
+		},
+	}}
+	config := v1alpha1.ConfigurationSpec{
+		ReportFormat: v1alpha1.JSONFormat,
+		ReportName:   reportName,
+		RerunFailed:  "previous.json",
+	}
+	_, err := run(context.Background(), &rest.Config{}, tclock.NewFakePassiveClock(time.Now()), config, &MockMainStart{code: 0}, tests...)
+	assert.NoError(t, err)
+	saved, err := report.Load(reportName + ".json")
+	assert.NoError(t, err)
+	assert.Equal(t, "previous.json", saved.RerunOf)
+}
+
+func TestRun_RerunFailed_NoFailures(t *testing.T) {
+	reportName := filepath.Join(t.TempDir(), "chainsaw")
+	config := v1alpha1.ConfigurationSpec{
+		ReportFormat: v1alpha1.JSONFormat,
+		ReportName:   reportName,
+		RerunFailed:  "previous.json",
+	}
+	summary, err := run(context.Background(), &rest.Config{}, tclock.NewFakePassiveClock(time.Now()), config, &MockMainStart{code: 0})
+	assert.NoError(t, err)
+	assert.NotNil(t, summary)
+	saved, err := report.Load(reportName + ".json")
+	assert.NoError(t, err)
+	assert.Equal(t, "previous.json", saved.RerunOf)
+	assert.Empty(t, saved.Reports)
+	assert.Equal(t, 0, saved.Test)
+}
+
+func TestRun_EmptyShard(t *testing.T) {
+	reportName := filepath.Join(t.TempDir(), "chainsaw")
+	tests := []discovery.Test{{
+		Test: &v1alpha1.Test{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+		},
+	}}
+	tests, err := discovery.Shard(tests, 1, 2, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, tests)
+	config := v1alpha1.ConfigurationSpec{
+		ReportFormat: v1alpha1.JSONFormat,
+		ReportName:   reportName,
+		Sharding: &v1alpha1.Sharding{
+			Index: 1,
+			Count: 2,
+		},
+	}
+	summary, err := run(context.Background(), &rest.Config{}, tclock.NewFakePassiveClock(time.Now()), config, &MockMainStart{code: 0}, tests...)
+	assert.NoError(t, err)
+	assert.NotNil(t, summary)
+	saved, err := report.Load(reportName + ".json")
+	assert.NoError(t, err)
+	assert.Empty(t, saved.RerunOf)
+	assert.Empty(t, saved.Reports)
+	assert.Equal(t, 0, saved.Test)
+}
@@ -7,6 +7,7 @@ import (
 type Summary struct {
 	passed  atomic.Int32
 	failed  atomic.Int32
+	flaky   atomic.Int32
 	skipped atomic.Int32
 }
 
@@ -18,6 +19,10 @@ func (s *Summary) IncFailed() {
 	s.failed.Add(1)
 }
 
+func (s *Summary) IncFlaky() {
+	s.flaky.Add(1)
+}
+
 func (s *Summary) IncSkipped() {
 	s.skipped.Add(1)
 }
@@ -30,6 +35,10 @@ func (s *Summary) Failed() int32 {
 	return s.failed.Load()
 }
 
+func (s *Summary) Flaky() int32 {
+	return s.flaky.Load()
+}
+
 func (s *Summary) Skipped() int32 {
 	return s.skipped.Load()
 }
@@ -12,7 +12,7 @@ func TestSummay(t *testing.T) {
 	var s Summary
 	const count int32 = 10000
 	for i := 0; i < int(count); i++ {
-		wg.Add(3)
+		wg.Add(4)
 		go func() {
 			defer wg.Done()
 			s.IncFailed()
@@ -21,6 +21,10 @@ func TestSummay(t *testing.T) {
 			defer wg.Done()
 			s.IncPassed()
 		}()
+		go func() {
+			defer wg.Done()
+			s.IncFlaky()
+		}()
 		go func() {
 			defer wg.Done()
 			s.IncSkipped()
@@ -29,5 +33,6 @@ func TestSummay(t *testing.T) {
 	wg.Wait()
 	assert.Equal(t, count, s.Failed())
 	assert.Equal(t, count, s.Passed())
+	assert.Equal(t, count, s.Flaky())
 	assert.Equal(t, count, s.Skipped())
 }
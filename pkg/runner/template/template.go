@@ -0,0 +1,115 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	jpfunctions "github.com/jmespath-community/go-jmespath/pkg/functions"
+	"github.com/jmespath-community/go-jmespath/pkg/interpreter"
+	"github.com/kyverno/chainsaw/pkg/runner/check/functions"
+	"github.com/kyverno/kyverno-json/pkg/engine/template"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var variable = regexp.MustCompile(`{{(.*?)}}`)
+
+// Caller is the function caller used to evaluate every JMESPath expression, it provides the kyverno-json and chainsaw functions.
+var Caller = func() interpreter.FunctionCaller {
+	var funcs []jpfunctions.FunctionEntry
+	funcs = append(funcs, template.GetFunctions(context.Background())...)
+	funcs = append(funcs, functions.GetFunctions()...)
+	return interpreter.NewFunctionCaller(funcs...)
+}()
+
+// Resource evaluates the templated values of the given resource, in place.
+func Resource(ctx context.Context, obj *unstructured.Unstructured, bindings binding.Bindings) error {
+	if obj == nil {
+		return nil
+	}
+	templated, err := Value(ctx, obj.UnstructuredContent(), bindings)
+	if err != nil {
+		return err
+	}
+	if templated, ok := templated.(map[string]any); ok {
+		obj.SetUnstructuredContent(templated)
+	}
+	return nil
+}
+
+// Value walks the given value and evaluates JMESPath expressions enclosed in `{{ }}` inside string values.
+// A string made of a single expression is replaced by the expression result, preserving its type.
+// Map keys are left untouched.
+func Value(ctx context.Context, value any, bindings binding.Bindings) (any, error) {
+	if bindings == nil {
+		bindings = binding.NewBindings()
+	}
+	switch typed := value.(type) {
+	case string:
+		return String(ctx, typed, bindings)
+	case map[string]any:
+		out := make(map[string]any, len(typed))
+		for key, value := range typed {
+			templated, err := Value(ctx, value, bindings)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = templated
+		}
+		return out, nil
+	case []any:
+		out := make([]any, 0, len(typed))
+		for _, value := range typed {
+			templated, err := Value(ctx, value, bindings)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, templated)
+		}
+		return out, nil
+	default:
+		return value, nil
+	}
+}
+
+// String evaluates JMESPath expressions enclosed in `{{ }}` in the given string.
+func String(ctx context.Context, in string, bindings binding.Bindings) (any, error) {
+	groups := variable.FindAllStringSubmatchIndex(in, -1)
+	if len(groups) == 0 {
+		return in, nil
+	}
+	// a single expression spanning the whole string keeps the result type
+	if len(groups) == 1 && groups[0][0] == 0 && groups[0][1] == len(in) {
+		return execute(ctx, in[groups[0][2]:groups[0][3]], bindings)
+	}
+	var out strings.Builder
+	last := 0
+	for _, group := range groups {
+		out.WriteString(in[last:group[0]])
+		result, err := execute(ctx, in[group[2]:group[3]], bindings)
+		if err != nil {
+			return nil, err
+		}
+		switch result.(type) {
+		case nil:
+			return nil, fmt.Errorf("failed to template %s (expression returned null)", in[group[0]:group[1]])
+		case map[string]any, []any:
+			return nil, fmt.Errorf("failed to template %s (expression must return a scalar value)", in[group[0]:group[1]])
+		}
+		out.WriteString(fmt.Sprint(result))
+		last = group[1]
+	}
+	out.WriteString(in[last:])
+	return out.String(), nil
+}
+
+func execute(ctx context.Context, statement string, bindings binding.Bindings) (any, error) {
+	statement = strings.TrimSpace(statement)
+	result, err := template.Execute(ctx, statement, nil, bindings, template.WithFunctionCaller(Caller))
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate %s (%w)", statement, err)
+	}
+	return result, nil
+}
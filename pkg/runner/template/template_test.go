@@ -0,0 +1,110 @@
+package template
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmespath-community/go-jmespath/pkg/binding"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestString(t *testing.T) {
+	bindings := binding.NewBindings().
+		Register("$namespace", binding.NewBinding("foo")).
+		Register("$replicas", binding.NewBinding(3.0)).
+		Register("$labels", binding.NewBinding(map[string]any{"app": "foo"}))
+	tests := []struct {
+		name    string
+		in      string
+		want    any
+		wantErr bool
+	}{{
+		name: "empty",
+		in:   "",
+		want: "",
+	}, {
+		name: "no expression",
+		in:   "foo",
+		want: "foo",
+	}, {
+		name: "single expression",
+		in:   "{{ $namespace }}",
+		want: "foo",
+	}, {
+		name: "typed expression",
+		in:   "{{ $replicas }}",
+		want: 3.0,
+	}, {
+		name: "object expression",
+		in:   "{{ $labels }}",
+		want: map[string]any{"app": "foo"},
+	}, {
+		name: "interpolation",
+		in:   "{{ $namespace }}-{{ $replicas }}-bar",
+		want: "foo-3-bar",
+	}, {
+		name: "function",
+		in:   "prefix-{{ to_upper($namespace) }}",
+		want: "prefix-FOO",
+	}, {
+		name:    "null interpolation",
+		in:      "prefix-{{ $labels.foo }}",
+		wantErr: true,
+	}, {
+		name:    "object interpolation",
+		in:      "prefix-{{ $labels }}",
+		wantErr: true,
+	}, {
+		name:    "invalid expression",
+		in:      "{{ foo[ }}",
+		wantErr: true,
+	}, {
+		name:    "unknown binding",
+		in:      "{{ $unknown }}",
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := String(context.TODO(), tt.in, bindings)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestResource(t *testing.T) {
+	bindings := binding.NewBindings().Register("$namespace", binding.NewBinding("foo"))
+	obj := unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]any{
+				"name": "{{ $namespace }}-config",
+			},
+			"data": map[string]any{
+				"({{ $namespace }})": "bar",
+				"list":               []any{"{{ $namespace }}", int64(42)},
+			},
+		},
+	}
+	assert.NoError(t, Resource(context.TODO(), &obj, bindings))
+	assert.Equal(t, "foo-config", obj.GetName())
+	assert.Equal(t, map[string]any{
+		"({{ $namespace }})": "bar",
+		"list":               []any{"foo", int64(42)},
+	}, obj.Object["data"])
+	bad := unstructured.Unstructured{
+		Object: map[string]any{
+			"metadata": map[string]any{
+				"name": "{{ foo[ }}",
+			},
+		},
+	}
+	assert.Error(t, Resource(context.TODO(), &bad, bindings))
+	assert.NoError(t, Resource(context.TODO(), nil, bindings))
+}
@@ -9,6 +9,7 @@ func ValidateAssert(path *field.Path, obj *v1alpha1.Assert) field.ErrorList {
 	var errs field.ErrorList
 	if obj != nil {
 		errs = append(errs, ValidateFileRefOrResource(path, obj.FileRefOrResource)...)
+		errs = append(errs, ValidateOutputs(path.Child("outputs"), obj.Outputs...)...)
 	}
 	return errs
 }
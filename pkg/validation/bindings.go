@@ -0,0 +1,28 @@
+package validation
+
+import (
+	"regexp"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var identifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func ValidateBinding(path *field.Path, obj v1alpha1.Binding) field.ErrorList {
+	var errs field.ErrorList
+	if obj.Name == "" {
+		errs = append(errs, field.Required(path.Child("name"), "a name must be specified"))
+	} else if !identifier.MatchString(obj.Name) {
+		errs = append(errs, field.Invalid(path.Child("name"), obj.Name, "name must be a valid identifier"))
+	}
+	return errs
+}
+
+func ValidateBindings(path *field.Path, bindings ...v1alpha1.Binding) field.ErrorList {
+	var errs field.ErrorList
+	for i := range bindings {
+		errs = append(errs, ValidateBinding(path.Index(i), bindings[i])...)
+	}
+	return errs
+}
@@ -0,0 +1,101 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateBindings(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     *field.Path
+		bindings []v1alpha1.Binding
+		want     field.ErrorList
+	}{{
+		name:     "nil",
+		path:     nil,
+		bindings: nil,
+		want:     nil,
+	}, {
+		name: "valid",
+		path: field.NewPath("foo"),
+		bindings: []v1alpha1.Binding{{
+			Name:  "foo_bar",
+			Value: v1alpha1.Check{Value: "bar"},
+		}},
+		want: nil,
+	}, {
+		name: "missing name",
+		path: field.NewPath("foo"),
+		bindings: []v1alpha1.Binding{{
+			Value: v1alpha1.Check{Value: "bar"},
+		}},
+		want: field.ErrorList{
+			field.Required(field.NewPath("foo").Index(0).Child("name"), "a name must be specified"),
+		},
+	}, {
+		name: "invalid name",
+		path: field.NewPath("foo"),
+		bindings: []v1alpha1.Binding{{
+			Name:  "foo-bar",
+			Value: v1alpha1.Check{Value: "bar"},
+		}},
+		want: field.ErrorList{
+			field.Invalid(field.NewPath("foo").Index(0).Child("name"), "foo-bar", "name must be a valid identifier"),
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateBindings(tt.path, tt.bindings...)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateOutputs(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    *field.Path
+		outputs []v1alpha1.Output
+		want    field.ErrorList
+	}{{
+		name:    "nil",
+		path:    nil,
+		outputs: nil,
+		want:    nil,
+	}, {
+		name: "invalid match",
+		path: field.NewPath("foo"),
+		outputs: []v1alpha1.Output{{
+			Binding: v1alpha1.Binding{
+				Name:  "foo",
+				Value: v1alpha1.Check{Value: "bar"},
+			},
+			Match: &v1alpha1.Check{},
+		}},
+		want: field.ErrorList{
+			field.Invalid(field.NewPath("foo").Index(0).Child("match"), &v1alpha1.Check{}, "a value must be specified"),
+		},
+	}, {
+		name: "invalid name",
+		path: field.NewPath("foo"),
+		outputs: []v1alpha1.Output{{
+			Binding: v1alpha1.Binding{
+				Name:  "$foo",
+				Value: v1alpha1.Check{Value: "bar"},
+			},
+		}},
+		want: field.ErrorList{
+			field.Invalid(field.NewPath("foo").Index(0).Child("name"), "$foo", "name must be a valid identifier"),
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateOutputs(tt.path, tt.outputs...)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -16,6 +16,12 @@ func ValidateCatch(path *field.Path, obj v1alpha1.Catch) field.ErrorList {
 	if obj.Events != nil {
 		count++
 	}
+	if obj.Get != nil {
+		count++
+	}
+	if obj.Describe != nil {
+		count++
+	}
 	if obj.Command != nil {
 		count++
 	}
@@ -32,8 +38,11 @@ func ValidateCatch(path *field.Path, obj v1alpha1.Catch) field.ErrorList {
 	} else {
 		errs = append(errs, ValidatePodLogs(path.Child("podLogs"), obj.PodLogs)...)
 		errs = append(errs, ValidateEvents(path.Child("events"), obj.Events)...)
+		errs = append(errs, ValidateGet(path.Child("get"), obj.Get)...)
+		errs = append(errs, ValidateDescribe(path.Child("describe"), obj.Describe)...)
 		errs = append(errs, ValidateCommand(path.Child("command"), obj.Command)...)
 		errs = append(errs, ValidateScript(path.Child("script"), obj.Script)...)
+		errs = append(errs, ValidateSleep(path.Child("sleep"), obj.Sleep)...)
 	}
 	return errs
 }
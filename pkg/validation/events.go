@@ -2,6 +2,7 @@ package validation
 
 import (
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -11,6 +12,9 @@ func ValidateEvents(path *field.Path, obj *v1alpha1.Events) field.ErrorList {
 		if obj.Name != "" && obj.Selector != "" {
 			errs = append(errs, field.Invalid(path, obj, "a name or label selector must be specified (found both)"))
 		}
+		if obj.Type != "" && obj.Type != corev1.EventTypeNormal && obj.Type != corev1.EventTypeWarning {
+			errs = append(errs, field.NotSupported(path.Child("type"), obj.Type, []string{corev1.EventTypeNormal, corev1.EventTypeWarning}))
+		}
 	}
 	return errs
 }
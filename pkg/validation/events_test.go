@@ -45,6 +45,21 @@ func TestValidateEvents(t *testing.T) {
 			input:     &v1alpha1.Events{},
 			expectErr: false,
 		},
+		{
+			name: "Valid type provided",
+			input: &v1alpha1.Events{
+				Type: "Warning",
+			},
+			expectErr: false,
+		},
+		{
+			name: "Invalid type provided",
+			input: &v1alpha1.Events{
+				Type: "Error",
+			},
+			expectErr: true,
+			errMsg:    `Unsupported value: "Error"`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,24 @@
+package validation
+
+import (
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateExec(path *field.Path, obj *v1alpha1.Exec) field.ErrorList {
+	var errs field.ErrorList
+	if obj != nil {
+		if obj.Name == "" && obj.Selector == "" {
+			errs = append(errs, field.Invalid(path, obj, "name or label selector must be specified"))
+		}
+		if obj.Name != "" && obj.Selector != "" {
+			errs = append(errs, field.Invalid(path, obj, "a name or label selector must be specified (found both)"))
+		}
+		if obj.Entrypoint == "" {
+			errs = append(errs, field.Invalid(path.Child("entrypoint"), obj, "entrypoint must be specified"))
+		}
+		errs = append(errs, ValidateCheck(path.Child("check"), obj.Check)...)
+		errs = append(errs, ValidateOutputs(path.Child("outputs"), obj.Outputs...)...)
+	}
+	return errs
+}
@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateExec(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *v1alpha1.Exec
+		expectErr bool
+		errMsg    string
+	}{{
+		name:      "Nil exec",
+		input:     nil,
+		expectErr: false,
+	}, {
+		name: "Neither Name nor Selector provided",
+		input: &v1alpha1.Exec{
+			Entrypoint: "cat",
+		},
+		expectErr: true,
+		errMsg:    "name or label selector must be specified",
+	}, {
+		name: "Both Name and Selector provided",
+		input: &v1alpha1.Exec{
+			Name:       "example-name",
+			Selector:   "app=example",
+			Entrypoint: "cat",
+		},
+		expectErr: true,
+		errMsg:    "a name or label selector must be specified (found both)",
+	}, {
+		name: "Missing entrypoint",
+		input: &v1alpha1.Exec{
+			Name: "example-name",
+		},
+		expectErr: true,
+		errMsg:    "entrypoint must be specified",
+	}, {
+		name: "Only Name provided",
+		input: &v1alpha1.Exec{
+			Name:       "example-name",
+			Entrypoint: "cat",
+			Args:       []string{"/etc/hostname"},
+		},
+		expectErr: false,
+	}, {
+		name: "Only Selector provided",
+		input: &v1alpha1.Exec{
+			Selector:   "app=example",
+			Entrypoint: "cat",
+			Args:       []string{"/etc/hostname"},
+		},
+		expectErr: false,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateExec(field.NewPath("testPath"), tt.input)
+			if tt.expectErr {
+				assert.NotEmpty(t, errs)
+				assert.Contains(t, errs.ToAggregate().Error(), tt.errMsg)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
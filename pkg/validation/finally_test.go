@@ -20,6 +20,18 @@ func TestValidateFinally(t *testing.T) {
 		Entrypoint: "echo",
 		Args:       []string{"Hello, World!"},
 	}
+	exampleGet := &v1alpha1.Get{
+		ObjectReference: v1alpha1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+	}
+	exampleDescribe := &v1alpha1.Describe{
+		ObjectReference: v1alpha1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Pod",
+		},
+	}
 	exampleScript := &v1alpha1.Script{
 		Content: "echo Hello, World!",
 	}
@@ -57,6 +69,29 @@ func TestValidateFinally(t *testing.T) {
 			Events: exampleEvents,
 		},
 		expectErr: false,
+	}, {
+		name: "Only Get statement provided",
+		input: v1alpha1.Finally{
+			Get: exampleGet,
+		},
+		expectErr: false,
+	}, {
+		name: "Get statement without kind",
+		input: v1alpha1.Finally{
+			Get: &v1alpha1.Get{
+				ObjectReference: v1alpha1.ObjectReference{
+					APIVersion: "v1",
+				},
+			},
+		},
+		expectErr: true,
+		errMsg:    "kind must be specified",
+	}, {
+		name: "Only Describe statement provided",
+		input: v1alpha1.Finally{
+			Describe: exampleDescribe,
+		},
+		expectErr: false,
 	}, {
 		name: "Only Command statement provided",
 		input: v1alpha1.Finally{
@@ -0,0 +1,14 @@
+package validation
+
+import (
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateGet(path *field.Path, obj *v1alpha1.Get) field.ErrorList {
+	var errs field.ErrorList
+	if obj != nil {
+		errs = append(errs, ValidateObjectReference(path.Child("ref"), obj.ObjectReference)...)
+	}
+	return errs
+}
@@ -0,0 +1,32 @@
+package validation
+
+import (
+	"net/url"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateHTTP(path *field.Path, obj *v1alpha1.HTTP) field.ErrorList {
+	var errs field.ErrorList
+	if obj != nil {
+		if obj.URL == "" {
+			errs = append(errs, field.Invalid(path.Child("url"), obj, "url must be specified"))
+		} else if u, err := url.Parse(obj.URL); err != nil {
+			errs = append(errs, field.Invalid(path.Child("url"), obj, err.Error()))
+		} else if obj.Target == nil && (u.Scheme == "" || u.Host == "") {
+			errs = append(errs, field.Invalid(path.Child("url"), obj, "url must be absolute when no target is specified"))
+		}
+		if obj.Target != nil {
+			if obj.Target.Name == "" {
+				errs = append(errs, field.Invalid(path.Child("target", "name"), obj, "name must be specified"))
+			}
+			if obj.Target.Port <= 0 {
+				errs = append(errs, field.Invalid(path.Child("target", "port"), obj, "port must be specified"))
+			}
+		}
+		errs = append(errs, ValidateCheck(path.Child("check"), obj.Check)...)
+		errs = append(errs, ValidateOutputs(path.Child("outputs"), obj.Outputs...)...)
+	}
+	return errs
+}
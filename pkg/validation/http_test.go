@@ -0,0 +1,84 @@
+package validation
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateHTTP(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *v1alpha1.HTTP
+		expectErr bool
+		errMsg    string
+	}{{
+		name:      "Nil http",
+		input:     nil,
+		expectErr: false,
+	}, {
+		name:      "Missing url",
+		input:     &v1alpha1.HTTP{},
+		expectErr: true,
+		errMsg:    "url must be specified",
+	}, {
+		name: "Relative url without target",
+		input: &v1alpha1.HTTP{
+			URL: "/healthz",
+		},
+		expectErr: true,
+		errMsg:    "url must be absolute when no target is specified",
+	}, {
+		name: "Target without name",
+		input: &v1alpha1.HTTP{
+			URL: "/healthz",
+			Target: &v1alpha1.HTTPTarget{
+				Kind: "Service",
+				Port: 80,
+			},
+		},
+		expectErr: true,
+		errMsg:    "name must be specified",
+	}, {
+		name: "Target without port",
+		input: &v1alpha1.HTTP{
+			URL: "/healthz",
+			Target: &v1alpha1.HTTPTarget{
+				Kind: "Service",
+				Name: "example",
+			},
+		},
+		expectErr: true,
+		errMsg:    "port must be specified",
+	}, {
+		name: "Absolute url",
+		input: &v1alpha1.HTTP{
+			URL: "https://example.com/healthz",
+		},
+		expectErr: false,
+	}, {
+		name: "Relative url with target",
+		input: &v1alpha1.HTTP{
+			URL: "/healthz",
+			Target: &v1alpha1.HTTPTarget{
+				Kind: "Service",
+				Name: "example",
+				Port: 80,
+			},
+		},
+		expectErr: false,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateHTTP(field.NewPath("testPath"), tt.input)
+			if tt.expectErr {
+				assert.NotEmpty(t, errs)
+				assert.Contains(t, errs.ToAggregate().Error(), tt.errMsg)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
@@ -28,24 +28,42 @@ func ValidateOperation(path *field.Path, obj v1alpha1.Operation) field.ErrorList
 	if obj.Error != nil {
 		count++
 	}
+	if obj.Exec != nil {
+		count++
+	}
+	if obj.HTTP != nil {
+		count++
+	}
+	if obj.Patch != nil {
+		count++
+	}
 	if obj.Script != nil {
 		count++
 	}
 	if obj.Sleep != nil {
 		count++
 	}
+	if obj.Wait != nil {
+		count++
+	}
 	if count == 0 {
 		errs = append(errs, field.Invalid(path, obj, "no statement found in operation"))
 	} else if count > 1 {
 		errs = append(errs, field.Invalid(path, obj, fmt.Sprintf("only one statement is allowed per operation (found %d)", count)))
 	} else {
+		errs = append(errs, ValidateBindings(path.Child("bindings"), obj.Bindings...)...)
 		errs = append(errs, ValidateApply(path.Child("apply"), obj.Apply)...)
 		errs = append(errs, ValidateAssert(path.Child("assert"), obj.Assert)...)
 		errs = append(errs, ValidateCommand(path.Child("command"), obj.Command)...)
 		errs = append(errs, ValidateCreate(path.Child("create"), obj.Create)...)
 		errs = append(errs, ValidateDelete(path.Child("delete"), obj.Delete)...)
 		errs = append(errs, ValidateError(path.Child("error"), obj.Error)...)
+		errs = append(errs, ValidateExec(path.Child("exec"), obj.Exec)...)
+		errs = append(errs, ValidateHTTP(path.Child("http"), obj.HTTP)...)
+		errs = append(errs, ValidatePatch(path.Child("patch"), obj.Patch)...)
 		errs = append(errs, ValidateScript(path.Child("script"), obj.Script)...)
+		errs = append(errs, ValidateSleep(path.Child("sleep"), obj.Sleep)...)
+		errs = append(errs, ValidateWait(path.Child("wait"), obj.Wait)...)
 	}
 	return errs
 }
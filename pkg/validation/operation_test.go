@@ -57,12 +57,45 @@ func TestValidateOperation(t *testing.T) {
 			},
 		},
 	}
+	examplePatch := &v1alpha1.Patch{
+		ObjectReference: v1alpha1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			ObjectSelector: v1alpha1.ObjectSelector{
+				Name: "chainsaw",
+			},
+		},
+		Patch: v1alpha1.Check{
+			Value: map[string]any{
+				"data": map[string]any{
+					"foo": "bar",
+				},
+			},
+		},
+	}
+	exampleExec := &v1alpha1.Exec{
+		Name:       "chainsaw",
+		Entrypoint: "hostname",
+	}
+	exampleHTTP := &v1alpha1.HTTP{
+		URL: "https://example.com/healthz",
+	}
 	exampleScript := &v1alpha1.Script{
 		Content: "echo 'hello world'",
 	}
 	exampleSleep := &v1alpha1.Sleep{
 		Duration: metav1.Duration{Duration: 5 * time.Second},
 	}
+	exampleWait := &v1alpha1.Wait{
+		ObjectReference: v1alpha1.ObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			ObjectSelector: v1alpha1.ObjectSelector{
+				Name: "chainsaw",
+			},
+		},
+		For: "condition=Available",
+	}
 	tests := []struct {
 		name      string
 		input     v1alpha1.Operation
@@ -118,6 +151,24 @@ func TestValidateOperation(t *testing.T) {
 			Error: exampleError,
 		},
 		expectErr: false,
+	}, {
+		name: "Only Exec operation statement provided",
+		input: v1alpha1.Operation{
+			Exec: exampleExec,
+		},
+		expectErr: false,
+	}, {
+		name: "Only HTTP operation statement provided",
+		input: v1alpha1.Operation{
+			HTTP: exampleHTTP,
+		},
+		expectErr: false,
+	}, {
+		name: "Only Patch operation statement provided",
+		input: v1alpha1.Operation{
+			Patch: examplePatch,
+		},
+		expectErr: false,
 	}, {
 		name: "Only Script operation statement provided",
 		input: v1alpha1.Operation{
@@ -130,6 +181,12 @@ func TestValidateOperation(t *testing.T) {
 			Sleep: exampleSleep,
 		},
 		expectErr: false,
+	}, {
+		name: "Only Wait operation statement provided",
+		input: v1alpha1.Operation{
+			Wait: exampleWait,
+		},
+		expectErr: false,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
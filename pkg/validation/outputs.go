@@ -0,0 +1,17 @@
+package validation
+
+import (
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateOutputs(path *field.Path, outputs ...v1alpha1.Output) field.ErrorList {
+	var errs field.ErrorList
+	for i := range outputs {
+		path := path.Index(i)
+		output := outputs[i]
+		errs = append(errs, ValidateBinding(path, output.Binding)...)
+		errs = append(errs, ValidateCheck(path.Child("match"), output.Match)...)
+	}
+	return errs
+}
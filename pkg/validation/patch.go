@@ -0,0 +1,29 @@
+package validation
+
+import (
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidatePatch(path *field.Path, obj *v1alpha1.Patch) field.ErrorList {
+	var errs field.ErrorList
+	if obj != nil {
+		errs = append(errs, ValidateObjectReference(path.Child("ref"), obj.ObjectReference)...)
+		if obj.Patch.Value == nil {
+			errs = append(errs, field.Invalid(path.Child("patch"), obj.Patch, "a patch must be specified"))
+		} else {
+			switch obj.Type {
+			case v1alpha1.JSONPatch:
+				if _, ok := obj.Patch.Value.([]any); !ok {
+					errs = append(errs, field.Invalid(path.Child("patch"), obj.Patch, "a json patch must be a list of operations"))
+				}
+			default:
+				if _, ok := obj.Patch.Value.(map[string]any); !ok {
+					errs = append(errs, field.Invalid(path.Child("patch"), obj.Patch, "a merge patch must be an object"))
+				}
+			}
+		}
+		errs = append(errs, ValidateExpectations(path.Child("expect"), obj.Expect...)...)
+	}
+	return errs
+}
@@ -0,0 +1,100 @@
+package validation
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidatePatch(t *testing.T) {
+	ref := v1alpha1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		ObjectSelector: v1alpha1.ObjectSelector{
+			Name: "chainsaw",
+		},
+	}
+	tests := []struct {
+		name      string
+		input     *v1alpha1.Patch
+		expectErr bool
+		errMsg    string
+	}{{
+		name:      "Nil patch",
+		input:     nil,
+		expectErr: false,
+	}, {
+		name: "Missing patch content",
+		input: &v1alpha1.Patch{
+			ObjectReference: ref,
+		},
+		expectErr: true,
+		errMsg:    "a patch must be specified",
+	}, {
+		name: "Missing kind",
+		input: &v1alpha1.Patch{
+			ObjectReference: v1alpha1.ObjectReference{
+				APIVersion: "v1",
+			},
+			Patch: v1alpha1.Check{
+				Value: map[string]any{"data": map[string]any{"foo": "bar"}},
+			},
+		},
+		expectErr: true,
+		errMsg:    "kind must be specified",
+	}, {
+		name: "Valid merge patch",
+		input: &v1alpha1.Patch{
+			ObjectReference: ref,
+			Patch: v1alpha1.Check{
+				Value: map[string]any{"data": map[string]any{"foo": "bar"}},
+			},
+		},
+		expectErr: false,
+	}, {
+		name: "Invalid merge patch",
+		input: &v1alpha1.Patch{
+			ObjectReference: ref,
+			Type:            v1alpha1.StrategicMergePatch,
+			Patch: v1alpha1.Check{
+				Value: []any{},
+			},
+		},
+		expectErr: true,
+		errMsg:    "a merge patch must be an object",
+	}, {
+		name: "Valid json patch",
+		input: &v1alpha1.Patch{
+			ObjectReference: ref,
+			Type:            v1alpha1.JSONPatch,
+			Patch: v1alpha1.Check{
+				Value: []any{map[string]any{"op": "remove", "path": "/data/foo"}},
+			},
+		},
+		expectErr: false,
+	}, {
+		name: "Invalid json patch",
+		input: &v1alpha1.Patch{
+			ObjectReference: ref,
+			Type:            v1alpha1.JSONPatch,
+			Patch: v1alpha1.Check{
+				Value: map[string]any{"data": map[string]any{"foo": "bar"}},
+			},
+		},
+		expectErr: true,
+		errMsg:    "a json patch must be a list of operations",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidatePatch(field.NewPath("testPath"), tt.input)
+			if tt.expectErr {
+				assert.NotEmpty(t, errs)
+				assert.Contains(t, errs.ToAggregate().Error(), tt.errMsg)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
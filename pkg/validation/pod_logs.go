@@ -14,6 +14,9 @@ func ValidatePodLogs(path *field.Path, obj *v1alpha1.PodLogs) field.ErrorList {
 		if obj.Name != "" && obj.Selector != "" {
 			errs = append(errs, field.Invalid(path, obj, "a name or label selector must be specified (found both)"))
 		}
+		if obj.Since != nil && obj.Since.Duration <= 0 {
+			errs = append(errs, field.Invalid(path.Child("since"), obj.Since, "since must be a positive duration"))
+		}
 	}
 	return errs
 }
@@ -2,9 +2,11 @@ package validation
 
 import (
 	"testing"
+	"time"
 
 	v1alpha1 "github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -49,6 +51,23 @@ func TestValidatePodLogs(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "Positive since provided",
+			input: &v1alpha1.PodLogs{
+				Name:  "example-name",
+				Since: &metav1.Duration{Duration: time.Minute},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Negative since provided",
+			input: &v1alpha1.PodLogs{
+				Name:  "example-name",
+				Since: &metav1.Duration{Duration: -time.Minute},
+			},
+			expectErr: true,
+			errMsg:    "since must be a positive duration",
+		},
 	}
 
 	for _, tt := range tests {
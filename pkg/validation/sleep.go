@@ -0,0 +1,17 @@
+package validation
+
+import (
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateSleep(path *field.Path, obj *v1alpha1.Sleep) field.ErrorList {
+	var errs field.ErrorList
+	if obj != nil && obj.Until != nil {
+		errs = append(errs, ValidateObjectReference(path.Child("until", "ref"), obj.Until.ObjectReference)...)
+		if obj.Until.Predicate == "" {
+			errs = append(errs, field.Required(path.Child("until", "predicate"), "predicate must be specified"))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,82 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateSleep(t *testing.T) {
+	ref := v1alpha1.ObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		ObjectSelector: v1alpha1.ObjectSelector{
+			Name: "chainsaw",
+		},
+	}
+	duration := metav1.Duration{Duration: 5 * time.Second}
+	tests := []struct {
+		name      string
+		input     *v1alpha1.Sleep
+		expectErr bool
+		errMsg    string
+	}{{
+		name:      "Nil sleep",
+		input:     nil,
+		expectErr: false,
+	}, {
+		name: "Without until",
+		input: &v1alpha1.Sleep{
+			Duration: duration,
+		},
+		expectErr: false,
+	}, {
+		name: "With until",
+		input: &v1alpha1.Sleep{
+			Duration: duration,
+			Until: &v1alpha1.SleepUntil{
+				ObjectReference: ref,
+				Predicate:       "status.readyReplicas == `1`",
+			},
+		},
+		expectErr: false,
+	}, {
+		name: "Missing predicate",
+		input: &v1alpha1.Sleep{
+			Duration: duration,
+			Until: &v1alpha1.SleepUntil{
+				ObjectReference: ref,
+			},
+		},
+		expectErr: true,
+		errMsg:    "predicate must be specified",
+	}, {
+		name: "Missing kind",
+		input: &v1alpha1.Sleep{
+			Duration: duration,
+			Until: &v1alpha1.SleepUntil{
+				ObjectReference: v1alpha1.ObjectReference{
+					APIVersion: "apps/v1",
+				},
+				Predicate: "status.readyReplicas == `1`",
+			},
+		},
+		expectErr: true,
+		errMsg:    "kind must be specified",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateSleep(field.NewPath("testPath"), tt.input)
+			if tt.expectErr {
+				assert.NotEmpty(t, errs)
+				assert.Contains(t, errs.ToAggregate().Error(), tt.errMsg)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
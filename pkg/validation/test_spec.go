@@ -7,6 +7,7 @@ import (
 
 func ValidateTestSpec(path *field.Path, obj v1alpha1.TestSpec) field.ErrorList {
 	var errs field.ErrorList
+	errs = append(errs, ValidateBindings(path.Child("bindings"), obj.Bindings...)...)
 	for i, step := range obj.Steps {
 		errs = append(errs, ValidateTestSpecStep(path.Child("steps").Index(i), step)...)
 	}
@@ -7,6 +7,7 @@ import (
 
 func ValidateTestStepSpec(path *field.Path, obj v1alpha1.TestStepSpec) field.ErrorList {
 	var errs field.ErrorList
+	errs = append(errs, ValidateBindings(path.Child("bindings"), obj.Bindings...)...)
 	for i, try := range obj.Try {
 		errs = append(errs, ValidateOperation(path.Child("try").Index(i), try)...)
 	}
@@ -0,0 +1,31 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateWait(path *field.Path, obj *v1alpha1.Wait) field.ErrorList {
+	var errs field.ErrorList
+	if obj != nil {
+		errs = append(errs, ValidateObjectReference(path.Child("ref"), obj.ObjectReference)...)
+		switch {
+		case obj.For == "":
+			errs = append(errs, field.Required(path.Child("for"), "for must be specified"))
+		case obj.For == v1alpha1.WaitForDelete:
+		case strings.HasPrefix(obj.For, v1alpha1.WaitForConditionPrefix):
+			if conditionType, _, _ := strings.Cut(strings.TrimPrefix(obj.For, v1alpha1.WaitForConditionPrefix), "="); conditionType == "" {
+				errs = append(errs, field.Invalid(path.Child("for"), obj.For, "a condition type must be specified"))
+			}
+		case strings.HasPrefix(obj.For, v1alpha1.WaitForJMESPathPrefix):
+			if strings.TrimPrefix(obj.For, v1alpha1.WaitForJMESPathPrefix) == "" {
+				errs = append(errs, field.Invalid(path.Child("for"), obj.For, "an expression must be specified"))
+			}
+		default:
+			errs = append(errs, field.Invalid(path.Child("for"), obj.For, "for must be delete, condition=<type>[=<status>] or jmespath=<expression>"))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,109 @@
+package validation
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateWait(t *testing.T) {
+	ref := v1alpha1.ObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		ObjectSelector: v1alpha1.ObjectSelector{
+			Name: "chainsaw",
+		},
+	}
+	tests := []struct {
+		name      string
+		input     *v1alpha1.Wait
+		expectErr bool
+		errMsg    string
+	}{{
+		name:      "Nil wait",
+		input:     nil,
+		expectErr: false,
+	}, {
+		name: "Missing for",
+		input: &v1alpha1.Wait{
+			ObjectReference: ref,
+		},
+		expectErr: true,
+		errMsg:    "for must be specified",
+	}, {
+		name: "Missing kind",
+		input: &v1alpha1.Wait{
+			ObjectReference: v1alpha1.ObjectReference{
+				APIVersion: "v1",
+			},
+			For: "delete",
+		},
+		expectErr: true,
+		errMsg:    "kind must be specified",
+	}, {
+		name: "Delete",
+		input: &v1alpha1.Wait{
+			ObjectReference: ref,
+			For:             "delete",
+		},
+		expectErr: false,
+	}, {
+		name: "Condition",
+		input: &v1alpha1.Wait{
+			ObjectReference: ref,
+			For:             "condition=Available",
+		},
+		expectErr: false,
+	}, {
+		name: "Condition with status",
+		input: &v1alpha1.Wait{
+			ObjectReference: ref,
+			For:             "condition=Progressing=False",
+		},
+		expectErr: false,
+	}, {
+		name: "Missing condition type",
+		input: &v1alpha1.Wait{
+			ObjectReference: ref,
+			For:             "condition==True",
+		},
+		expectErr: true,
+		errMsg:    "a condition type must be specified",
+	}, {
+		name: "JMESPath",
+		input: &v1alpha1.Wait{
+			ObjectReference: ref,
+			For:             "jmespath=status.readyReplicas == spec.replicas",
+		},
+		expectErr: false,
+	}, {
+		name: "Missing expression",
+		input: &v1alpha1.Wait{
+			ObjectReference: ref,
+			For:             "jmespath=",
+		},
+		expectErr: true,
+		errMsg:    "an expression must be specified",
+	}, {
+		name: "Invalid for",
+		input: &v1alpha1.Wait{
+			ObjectReference: ref,
+			For:             "ready",
+		},
+		expectErr: true,
+		errMsg:    "for must be delete, condition=<type>[=<status>] or jmespath=<expression>",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateWait(field.NewPath("testPath"), tt.input)
+			if tt.expectErr {
+				assert.NotEmpty(t, errs)
+				assert.Contains(t, errs.ToAggregate().Error(), tt.errMsg)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}